@@ -22,6 +22,7 @@ COPYRIGHT:
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
@@ -84,7 +85,7 @@ func init() {
 			"web.app/.",
 	)
 	flaggy.String(
-		&tempDirectory, "tmp", "tempDir", "The temporary directory where the Ai2 Client can read and write temporary files.",
+		&tempDirectory, "tmp", "tempDir", "The temporary directory where the Ai2 Client can read and write temporary files. When omitted, nothing is written to disk.",
 	)
 	flaggy.Bool(&testingOn, "t", "testingOn", "This puts the program into testing mode.")
 	flaggy.String(&username, "u", "username", "The username you selected when you signed up for AI2 connect services. This is encrypted using SSL and only exist in Cognito.")
@@ -116,7 +117,7 @@ func main() {
 			pi.PrintError(pi.ErrVersionInvalid, fmt.Sprintf("%v %v", ctv.TXT_SERVER_VERSION, version))
 			flaggy.ShowHelpAndExit("")
 		}
-		if username == ctv.VAL_EMPTY || password == ctv.VAL_EMPTY || styhClientId == ctv.VAL_EMPTY || secretKey == ctv.VAL_EMPTY || tempDirectory == ctv.VAL_EMPTY {
+		if username == ctv.VAL_EMPTY || password == ctv.VAL_EMPTY || styhClientId == ctv.VAL_EMPTY || secretKey == ctv.VAL_EMPTY {
 			// Has the config file location and name been provided, if not, return help.
 			if configFileFQN == "" || configFileFQN == "-t" {
 				flaggy.ShowHelpAndExit("")
@@ -132,71 +133,83 @@ func main() {
 func run(styhClientId, environment, password, secretKey, tempDirectory, username, configFileFQN string) {
 
 	var (
-		clientPtr               src.Ai2CClient
-		data                    src.Ai2CPaymentInfo
+		amount                  src.Money
+		clientPtr               *src.Ai2CClient
 		errorInfo               pi.ErrorInfo
+		options                 []src.Option
 		stripePublicKeyGoesHere = "sk_test_51LalVGK3aJ31D0ASERSRRZ5bxTaMBMm7v5CYgCtLkJ8QCzyd3TecGD4Kv3Wk6NkCWL3LOplumLK30cA3RqOnNtK400cDqiATbp"
-		reply                   []byte
+		paymentIntent           src.PaymentIntent
+		paymentIntentList       src.PaymentIntentList
+		paymentMethodList       src.PaymentMethodList
 	)
 
 	// The following is all the code the developer needs to use Ai2Connect.io
 
 	// Connect to the Ai2Connect service.
-	if clientPtr, errorInfo = src.NewAI2CClient(styhClientId, environment, password, secretKey, tempDirectory, username, configFileFQN); errorInfo.Error != nil {
+	if configFileFQN == ctv.VAL_EMPTY {
+		options = []src.Option{
+			src.WithCredentials(styhClientId, username, password, secretKey),
+			src.WithEnvironment(environment),
+			src.WithTempDirectory(tempDirectory),
+		}
+	} else {
+		options = []src.Option{
+			src.WithConfigFile(configFileFQN),
+		}
+	}
+	if clientPtr, errorInfo = src.New(options...); errorInfo.Error != nil {
 		pi.PrintErrorInfo(errorInfo)
 		flaggy.ShowHelpAndExit("")
 	}
+	defer clientPtr.Close()
 
 	// List available payment methods
-	data = src.Ai2CPaymentInfo{
-		PaymentMethod: ctv.PAYMENT_METHOD_LIST,
-		Keys: src.SaaSKeys{
-			Public: stripePublicKeyGoesHere,
-			Secret: "",
+	if paymentMethodList, errorInfo = clientPtr.ListPaymentMethods(
+		context.Background(),
+		src.ListPaymentMethodRequest{
+			SaaSKey: stripePublicKeyGoesHere,
 		},
-	}
-	if reply, errorInfo = clientPtr.AI2PaymentRequest(data); errorInfo.Error != nil {
+	); errorInfo.Error != nil {
 		pi.PrintErrorInfo(errorInfo)
 	} else {
 		fmt.Println("==============================")
 		fmt.Println("List available payment methods")
-		s, _ := prettyjson.Format(reply)
+		s, _ := prettyjson.Format(paymentMethodList.Raw)
 		fmt.Println(string(s))
 	}
 
-	// Create a payment
-	data = src.Ai2CPaymentInfo{
-		Amount:                    123.34,
-		UseAutomaticPaymentMethod: false,
-		Currency:                  ctv.CurrencyUSD,
-		Keys: src.SaaSKeys{
-			Public: stripePublicKeyGoesHere,
-			Secret: "",
+	// Create a payment of 123.34 dollars. NewMoney takes the amount in cents.
+	if amount, errorInfo = src.NewMoney(12334, ctv.CurrencyUSD); errorInfo.Error != nil {
+		pi.PrintErrorInfo(errorInfo)
+	} else if paymentIntent, errorInfo = clientPtr.CreatePaymentIntent(
+		context.Background(),
+		src.PaymentIntentRequest{
+			Amount:                  amount,
+			AutomaticPaymentMethods: false,
+			SaaSKey:                 stripePublicKeyGoesHere,
 		},
-	}
-	if reply, errorInfo = clientPtr.AI2PaymentRequest(data); errorInfo.Error != nil {
+	); errorInfo.Error != nil {
 		pi.PrintErrorInfo(errorInfo)
 	} else {
 		fmt.Println("==============================")
 		fmt.Println("Create a payment")
-		s, _ := prettyjson.Format(reply)
+		s, _ := prettyjson.Format(paymentIntent.Raw)
 		fmt.Println(string(s))
 	}
 
 	// List a payment
-	data = src.Ai2CPaymentInfo{
-		ReturnRecordsLimit: 1,
-		Keys: src.SaaSKeys{
-			Public: stripePublicKeyGoesHere,
-			Secret: "",
+	if paymentIntentList, errorInfo = clientPtr.ListPaymentIntents(
+		context.Background(),
+		src.ListPaymentIntentRequest{
+			SaaSKey: stripePublicKeyGoesHere,
+			Limit:   1,
 		},
-	}
-	if reply, errorInfo = clientPtr.AI2PaymentRequest(data); errorInfo.Error != nil {
+	); errorInfo.Error != nil {
 		pi.PrintErrorInfo(errorInfo)
 	} else {
 		fmt.Println("==============================")
 		fmt.Println("List a payment")
-		s, _ := prettyjson.Format(reply)
+		s, _ := prettyjson.Format(paymentIntentList.Raw)
 		fmt.Println(string(s))
 	}
 
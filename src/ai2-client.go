@@ -26,18 +26,18 @@ COPYRIGHT:
 package src
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"runtime"
-	"strconv"
 	"strings"
+	"sync"
 	"time"
 
-	awsSSM "github.com/aws/aws-sdk-go-v2/service/ssm"
 	"github.com/nats-io/nats.go"
 	ctv "github.com/sty-holdings/constant-type-vars-go/v2024"
 	awss "github.com/sty-holdings/sty-shared/v2024/awsServices"
-	cfgs "github.com/sty-holdings/sty-shared/v2024/configuration"
 	hv "github.com/sty-holdings/sty-shared/v2024/helpersValidators"
 	jwts "github.com/sty-holdings/sty-shared/v2024/jwtServices"
 	ns "github.com/sty-holdings/sty-shared/v2024/natsSerices"
@@ -48,18 +48,83 @@ import (
 const (
 	PROGRAM_NAME              = "ai2c-go-client"
 	AI2C_SSM_PARAMETER_PREFIX = "ai2c"
+	DEFAULT_REQUEST_TIMEOUT   = 2 * time.Second
+	LIST_LIMIT_MIN            = 1
+	LIST_LIMIT_MAX            = 100
+)
+
+//goland:noinspection ALL
+const (
+	CAPTURE_METHOD_AUTOMATIC = "automatic"
+	CAPTURE_METHOD_MANUAL    = "manual"
+)
+
+//goland:noinspection ALL
+const (
+	FN_AMOUNT              = "amount"
+	FN_AMOUNT_TO_CAPTURE   = "amount_to_capture"
+	FN_CANCELLATION_REASON = "cancellation_reason"
+	FN_CAPTURE_METHOD      = "capture_method"
+	FN_CURRENCY            = "currency"
+	FN_PAYMENT_INTENT_ID   = "id"
+	FN_PAYMENT_METHOD      = "payment_method"
+	FN_REQUEST_TIMEOUT     = "request_timeout_seconds"
+	FN_SAAS_KEY            = "saas_key"
+)
+
+// These are the subjects for payment intent operations that the constant-type-vars package does not define. They
+// must match the subjects the AI2C service subscribes to.
+//
+//goland:noinspection ALL
+const (
+	SUB_STRIPE_CAPTURE_PAYMENT_INTENT = "stripe.payment-intent.capture"
+	SUB_STRIPE_CONFIRM_PAYMENT_INTENT = "stripe.payment-intent.confirm"
+	SUB_STRIPE_GET_PAYMENT_INTENT     = "stripe.payment-intent.get"
+	SUB_STRIPE_UPDATE_PAYMENT_INTENT  = "stripe.payment-intent.update"
+)
+
+//goland:noinspection ALL
+const (
+	TXT_CAPTURE_METHOD               = "Capture method: "
+	TXT_DATE_RANGE                   = "Date range: "
+	TXT_LIMIT                        = "Limit: "
+	TXT_PAYMENT_INTENT               = "Payment intent: "
+	TXT_NATS_URL                     = "NATS URL: "
+	TXT_REQUEST_TIMEOUT              = "Request timeout: "
+	TXT_UNDETERMINED_PAYMENT_REQUEST = "The payment request could not be determined from the fields provided."
+)
+
+var (
+	ErrCaptureMethodInvalid = errors.New("the capture method must be automatic or manual")
+	ErrDateRangeInvalid     = errors.New("the date range must not be negative and must start before it ends")
+	ErrLimitOutOfRange      = errors.New("the limit must be between 1 and 100")
+	ErrNoChanges            = errors.New("no changes were provided")
+	ErrTimeoutInvalid       = errors.New("the request timeout must be positive")
 )
 
 type Ai2CClient struct {
-	awsSettings        awss.AWSSettings
-	environment        string
-	natsService        ns.NATSService
-	natsConfig         ns.NATSConfiguration
-	secretKey          string
-	styhCustomerConfig styhCustomerConfig
-	tempDirectory      string
+	awsSettings         awss.AWSSettings
+	closed              bool
+	closedLock          sync.RWMutex // Also guards natsService.ConnPtr, connInFlightPtr, and natsConfig once the client is built.
+	connInFlightPtr     *sync.WaitGroup
+	environment         string
+	inFlight            sync.WaitGroup
+	inMemoryCredentials bool
+	logger              Logger
+	natsConfig          ns.NATSConfiguration
+	natsOptions         []nats.Option
+	natsService         ns.NATSService
+	parameterSource     ParameterSource
+	requestTimeout      time.Duration
+	secretKey           string
+	styhCustomerConfig  styhCustomerConfig
+	tempDirectory       string
+	tokenManagerPtr     *tokenManager
 }
 
+// Ai2CPaymentInfo - the request used by AI2PaymentRequest. Amount is a float64 in the major unit, such as 123.34
+// dollars. New code should use the typed requests, which hold amounts as Money. CaptureFunds is the capture method,
+// automatic or manual, when creating a payment. With a PaymentIntentId, it requests a capture.
 type Ai2CPaymentInfo struct {
 	Amount                    float64  `json:"amount,omitempty"`
 	UseAutomaticPaymentMethod bool     `json:"use_automatic_payment_method,omitempty"`
@@ -83,6 +148,37 @@ type CancelPaymentIntentRequest struct {
 	CancellationReason string `json:"cancellation_reason"`
 }
 
+// CapturePaymentIntentRequest - captures AmountToCapture, which must not be more than the amount authorized. When
+// AmountToCapture is nil, the full amount is captured. The remaining amount is released.
+type CapturePaymentIntentRequest struct {
+	SaaSKey         string `json:"saas_key"`
+	PaymentIntentId string `json:"id"`
+	AmountToCapture *Money `json:"-"`
+}
+
+// ConfirmPaymentIntentRequest - ReturnURL is where the customer is sent after completing 3-D Secure or another
+// redirect based authentication. It is required by payment methods that redirect.
+type ConfirmPaymentIntentRequest struct {
+	SaaSKey         string `json:"saas_key"`
+	PaymentIntentId string `json:"id"`
+	PaymentMethodId string `json:"payment_method"`
+	ReturnURL       string `json:"return_url,omitempty"`
+}
+
+// DateRange - filters a list by Unix timestamps. Gt and Gte are after, or on or after, and Lt and Lte are before, or
+// on or before. Only the bounds that are set are sent.
+type DateRange struct {
+	Gt  int64 `json:"gt,omitempty"`
+	Gte int64 `json:"gte,omitempty"`
+	Lt  int64 `json:"lt,omitempty"`
+	Lte int64 `json:"lte,omitempty"`
+}
+
+type GetPaymentIntentRequest struct {
+	SaaSKey         string `json:"saas_key"`
+	PaymentIntentId string `json:"id"`
+}
+
 type ListPaymentIntentRequest struct {
 	SaaSKey       string `json:"saas_key"`
 	CustomerId    string `json:"customer_id,omitempty"`
@@ -94,16 +190,22 @@ type ListPaymentMethodRequest struct {
 	SaaSKey string `json:"saas_key"`
 }
 
+// PaymentIntentRequest - Amount is sent as a decimal amount in the major unit with the currency next to it, for
+// example "amount": 123.34, "currency": "usd". CaptureMethod set to CAPTURE_METHOD_MANUAL only authorizes the
+// amount, which is captured later with CapturePaymentIntent. The default is automatic. Confirm confirms the payment
+// intent when it is created and requires PaymentMethodId. CustomerId attaches the payment intent to a customer.
 type PaymentIntentRequest struct {
-	Amount                  float64 `json:"amount"`
-	AutomaticPaymentMethods bool    `json:"automatic_payment_methods,omitempty"`
-	Currency                string  `json:"currency"`
-	Description             string  `json:"description,omitempty"`
-	SaaSKey                 string  `json:"saas_key"`
-	ReceiptEmail            string  `json:"receipt_email"`
-	ReturnURL               string  `json:"return_url,omitempty"`
-	// Confirm            bool     `json:"confirm,omitempty"`
-	// PaymentMethodTypes []string `json:"payment_method_types,omitempty"`
+	Amount                  Money    `json:"-"`
+	AutomaticPaymentMethods bool     `json:"automatic_payment_methods,omitempty"`
+	CaptureMethod           string   `json:"capture_method,omitempty"`
+	Confirm                 bool     `json:"confirm,omitempty"`
+	CustomerId              string   `json:"customer,omitempty"`
+	Description             string   `json:"description,omitempty"`
+	PaymentMethodId         string   `json:"payment_method,omitempty"`
+	PaymentMethodTypes      []string `json:"payment_method_types,omitempty"`
+	SaaSKey                 string   `json:"saas_key"`
+	ReceiptEmail            string   `json:"receipt_email"`
+	ReturnURL               string   `json:"return_url,omitempty"`
 }
 
 type SaaSKeys struct {
@@ -111,144 +213,137 @@ type SaaSKeys struct {
 	Secret string `json:"secret_key,omitempty"`
 }
 
+// UpdatePaymentIntentRequest - only the fields that are set are changed. Amount is sent the same way as in
+// PaymentIntentRequest. Setting Description or ReceiptEmail to a pointer to an empty string clears it. Metadata keys
+// are added or replaced, and a key with an empty value is removed.
+type UpdatePaymentIntentRequest struct {
+	SaaSKey         string            `json:"saas_key"`
+	PaymentIntentId string            `json:"id"`
+	Amount          *Money            `json:"-"`
+	Description     *string           `json:"description,omitempty"`
+	Metadata        map[string]string `json:"metadata,omitempty"`
+	ReceiptEmail    *string           `json:"receipt_email,omitempty"`
+}
+
 type styhCustomerConfig struct {
 	clientId  string
 	secretKey string
-	tokens    awss.CognitoTokens
 	username  string
 }
 
-func NewAI2CClient(styhClientId, environment, password, secretKey, tempDirectory, username, configFileFQN string) (
-	ai2cClientPtr Ai2CClient,
+// New - builds an Ai2CClient from the options, logs into AWS Cognito, retrieves the AI2C parameters from AWS SSM or
+// the parameter source, and connects to the NATS service. The settings are validated in a single pass after the
+// options and configuration file have been applied. The environment must be set, either with WithEnvironment or in
+// the configuration file. The Cognito tokens are kept valid until the client is closed. Each time they are renewed,
+// the parameters are read from AWS SSM again and, when they have changed, the client reconnects to the NATS service.
+// When WithNATSConnection is used, the connection is used as is and neither AWS nor the parameter source is contacted.
+//
+// Customer Messages: None
+// Errors: ErrEnvironmentInvalid, ErrParameterInvalid, ErrParameterMissing, ErrRequiredArgumentMissing, ErrTimeoutInvalid
+// Verifications: validateConfiguration
+func New(opts ...Option) (
+	ai2cClientPtr *Ai2CClient,
 	errorInfo pi.ErrorInfo,
 ) {
 
 	var (
-		tEnvironment   string
-		tPassword      string
-		tSecretKey     string
-		tSTYHClientId  string
-		tTempDirectory string
-		tUsername      string
-	)
-
-	var (
-		tConfigMap = make(map[string]interface{})
+		tClientPtr = &Ai2CClient{connInFlightPtr: &sync.WaitGroup{}}
+		tConfig    clientConfig
+		tPassword  string
 	)
 
-	if configFileFQN == ctv.VAL_EMPTY {
-		if styhClientId == ctv.VAL_EMPTY {
-			errorInfo = pi.NewErrorInfo(pi.ErrRequiredArgumentMissing, fmt.Sprintf("%v%v", ctv.TXT_MISSING_PARAMETER, ctv.FN_CLIENT_ID))
-			return
-		} else {
-			tSTYHClientId = styhClientId
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&tConfig)
 		}
-		if password == ctv.VAL_EMPTY {
-			errorInfo = pi.NewErrorInfo(pi.ErrRequiredArgumentMissing, fmt.Sprintf("%v%v", ctv.TXT_MISSING_PARAMETER, ctv.FN_PASSWORD))
-			return
-		} else {
-			tPassword = password
-		}
-		if secretKey == ctv.VAL_EMPTY {
-			errorInfo = pi.NewErrorInfo(pi.ErrRequiredArgumentMissing, fmt.Sprintf("%v%v", ctv.TXT_MISSING_PARAMETER, ctv.FN_SECRET_KEY))
-			return
-		} else {
-			tSecretKey = secretKey
-		}
-		if tempDirectory == ctv.VAL_EMPTY {
-			errorInfo = pi.NewErrorInfo(pi.ErrRequiredArgumentMissing, fmt.Sprintf("%v%v", ctv.TXT_MISSING_PARAMETER, ctv.FN_TEMP_DIRECTORY))
-			return
-		} else {
-			tTempDirectory = tempDirectory
-		}
-		if username == ctv.VAL_EMPTY {
-			errorInfo = pi.NewErrorInfo(pi.ErrRequiredArgumentMissing, fmt.Sprintf("%v%v", ctv.TXT_MISSING_PARAMETER, ctv.FN_USERNAME))
-			return
-		} else {
-			tUsername = username
+	}
+	tClientPtr.logger = tConfig.logger
+
+	// Remove any temporary files that were written if the client can't be built.
+	defer func() {
+		if errorInfo.Error != nil {
+			tClientPtr.Close()
 		}
-		// environment is validated in awss.NewAWSConfig
-		tEnvironment = environment
-	} else {
-		if tConfigMap, errorInfo = cfgs.GetConfigFile(configFileFQN); errorInfo.Error != nil {
+	}()
+
+	if tConfig.configFileFQN != ctv.VAL_EMPTY {
+		if errorInfo = tConfig.loadConfigFile(); errorInfo.Error != nil {
+			tClientPtr.printErrorInfo(errorInfo)
 			return
 		}
-		tSTYHClientId = tConfigMap[ctv.FN_STYH_CLIENT_ID].(string)
-		tEnvironment = tConfigMap[ctv.FN_ENVIRONMENT].(string)
-		tPassword = tConfigMap[ctv.FN_PASSWORD].(string)
-		tConfigMap[ctv.FN_PASSWORD] = ctv.TXT_PROTECTED // Clear the password from memory.
-		tSecretKey = tConfigMap[ctv.FN_SECRET_KEY].(string)
-		tTempDirectory = tConfigMap[ctv.FN_TEMP_DIRECTORY].(string)
-		tUsername = tConfigMap[ctv.FN_USERNAME].(string)
 	}
+	tPassword = tConfig.password
+	tConfig.password = ctv.TXT_PROTECTED // Clear the password from memory.
 
-	if errorInfo = validateConfiguration(tSTYHClientId, tEnvironment, tSecretKey, tTempDirectory, tUsername, &tPassword); errorInfo.Error != nil {
-		pi.PrintErrorInfo(errorInfo)
+	if errorInfo = validateConfiguration(
+		tConfig.styhClientId, tConfig.environment, tConfig.secretKey, tConfig.username, &tPassword, tConfig.natsConnPtr == nil,
+		tConfig.requestTimeout,
+	); errorInfo.Error != nil {
+		tClientPtr.printErrorInfo(errorInfo)
 		return
 	}
 
-	if ai2cClientPtr.awsSettings, errorInfo = awss.LoadAWSCustomerSettings(tEnvironment); errorInfo.Error != nil {
-		pi.PrintErrorInfo(errorInfo)
+	tClientPtr.environment = tConfig.environment
+	tClientPtr.inMemoryCredentials = tConfig.inMemoryCredentials || tConfig.tempDirectory == ctv.VAL_EMPTY
+	tClientPtr.natsOptions = tConfig.natsOptions
+	tClientPtr.requestTimeout = tConfig.requestTimeout
+	tClientPtr.styhCustomerConfig.clientId = tConfig.styhClientId
+	tClientPtr.styhCustomerConfig.username = tConfig.username
+	tClientPtr.secretKey = tConfig.secretKey
+	tClientPtr.tempDirectory = tConfig.tempDirectory
+	tConfig.secretKey = ctv.TXT_PROTECTED // Clear the secret key from memory.
+
+	if tClientPtr.natsService.InstanceName, errorInfo = ns.BuildInstanceName(ns.METHOD_DASHES, tClientPtr.styhCustomerConfig.clientId); errorInfo.Error != nil {
+		tClientPtr.printErrorInfo(errorInfo)
 		return
 	}
-	ai2cClientPtr.environment = tEnvironment
-	ai2cClientPtr.tempDirectory = tTempDirectory
 
-	// This returns information about the STYH Customer
-	if ai2cClientPtr.styhCustomerConfig.tokens.Access,
-		ai2cClientPtr.styhCustomerConfig.tokens.ID,
-		ai2cClientPtr.styhCustomerConfig.tokens.Refresh, errorInfo = awss.Login(
-		ctv.AUTH_USER_SRP, tUsername, &tPassword,
-		ai2cClientPtr.awsSettings.STYHCognitoIdentityInfo, ai2cClientPtr.awsSettings.BaseConfig,
-	); errorInfo.Error != nil {
-		pi.PrintErrorInfo(errorInfo)
-		return
+	if tConfig.natsConnPtr == nil {
+		errorInfo = tClientPtr.connect(&tConfig, &tPassword)
+	} else {
+		tClientPtr.natsService.ConnPtr = tConfig.natsConnPtr
 	}
-
-	ai2cClientPtr.styhCustomerConfig.clientId = tSTYHClientId
-	ai2cClientPtr.styhCustomerConfig.username = tUsername
-	ai2cClientPtr.secretKey = tSecretKey
-	tPassword = ctv.TXT_PROTECTED  // Clear the password from memory.
-	secretKey = ctv.TXT_PROTECTED  // Clear the secret key from memory.
-	tSecretKey = ctv.TXT_PROTECTED // Clear the secret key from memory.
-
-	if errorInfo = processAWSClientParameters(
-		ai2cClientPtr.awsSettings,
-		ai2cClientPtr.styhCustomerConfig.tokens.ID,
-		tEnvironment,
-		&ai2cClientPtr.natsConfig,
-	); errorInfo.Error != nil {
-		pi.PrintErrorInfo(errorInfo)
+	tPassword = ctv.TXT_PROTECTED // Clear the password from memory.
+	if errorInfo.Error != nil {
+		tClientPtr.printErrorInfo(errorInfo)
 		return
 	}
 
-	if errorInfo = ns.BuildTemporaryFiles(ai2cClientPtr.tempDirectory, ai2cClientPtr.natsConfig); errorInfo.Error != nil {
-		pi.PrintErrorInfo(errorInfo)
-		return
+	if tClientPtr.tokenManagerPtr != nil {
+		tClientPtr.tokenManagerPtr.start()
 	}
-	ai2cClientPtr.natsConfig.NATSCredentialsFilename = fmt.Sprintf("%v/%v", tTempDirectory, ns.CREDENTIAL_FILENAME)
+	ai2cClientPtr = tClientPtr
 
-	if errorInfo = jwts.BuildTLSTemporaryFiles(ai2cClientPtr.tempDirectory, ai2cClientPtr.natsConfig.NATSTLSInfo); errorInfo.Error != nil {
-		pi.PrintErrorInfo(errorInfo)
-		return
-	}
-	ai2cClientPtr.natsConfig.NATSTLSInfo.TLSCABundleFQN = fmt.Sprintf("%v/%v", tTempDirectory, jwts.TLS_CA_BUNDLE_FILENAME)
-	ai2cClientPtr.natsConfig.NATSTLSInfo.TLSCertFQN = fmt.Sprintf("%v/%v", tTempDirectory, jwts.TLS_CERT_FILENAME)
-	ai2cClientPtr.natsConfig.NATSTLSInfo.TLSPrivateKeyFQN = fmt.Sprintf("%v/%v", tTempDirectory, jwts.TLS_PRIVATE_KEY_FILENAME)
+	return
+}
 
-	if ai2cClientPtr.natsService.InstanceName, errorInfo = ns.BuildInstanceName(ns.METHOD_DASHES, ai2cClientPtr.styhCustomerConfig.clientId); errorInfo.Error != nil {
-		pi.PrintErrorInfo(errorInfo)
-		return
-	}
-	if ai2cClientPtr.natsService.ConnPtr, errorInfo = ns.GetConnection(ai2cClientPtr.natsService.InstanceName, ai2cClientPtr.natsConfig); errorInfo.Error != nil {
-		pi.PrintErrorInfo(errorInfo)
-		return
+// NewAI2CClient - builds an Ai2CClient from either the arguments or, when configFileFQN is provided, the configuration
+// file, by calling New. It returns a pointer, not an Ai2CClient as before, because the client holds locks and must
+// not be copied. Callers that stored the returned value must now store the pointer.
+//
+// Customer Messages: None
+// Errors: ErrEnvironmentInvalid, ErrRequiredArgumentMissing
+// Verifications: validateConfiguration
+func NewAI2CClient(styhClientId, environment, password, secretKey, tempDirectory, username, configFileFQN string) (
+	ai2cClientPtr *Ai2CClient,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if configFileFQN == ctv.VAL_EMPTY {
+		return New(
+			WithCredentials(styhClientId, username, password, secretKey),
+			WithEnvironment(environment),
+			WithTempDirectory(tempDirectory),
+		)
 	}
 
-	return
+	return New(WithConfigFile(configFileFQN))
 }
 
 // AI2PaymentRequest - handles all payment requests. The SaaS providers public or secret key must be provided.
+// This is kept for compatibility and determines the operation from the fields that are set. New code should
+// call CreatePaymentIntent, ConfirmPaymentIntent, CapturePaymentIntent, CancelPaymentIntent, ListPaymentIntents, or
+// ListPaymentMethods directly.
 //
 // **Cancelling a payment**
 // CancellationReason in ai2CPaymentInfo specifies the reason for cancellation.
@@ -263,11 +358,21 @@ func NewAI2CClient(styhClientId, environment, password, secretKey, tempDirectory
 // **List Payment Methods**
 // List payment methods is requested when the PaymentMethod is set to LIST.
 //
+// **Capture Payment**
+// Captures the payment intent identified by PaymentIntentId when CaptureFunds is set. When Amount is positive, only
+// that amount in the Currency is captured. Otherwise, the full amount is captured.
+//
+// **Confirm Payment**
+// Confirms the payment intent identified by PaymentIntentId with the PaymentMethod. The ReturnURL is used when
+// the customer must authenticate, for example with 3-D Secure.
+//
 // **Create Payment**
-// Creates a payment request when positive amount and the currency are provided.
+// Creates a payment request when positive amount and the currency are provided. The amount is in the major unit
+// and is rounded to the currency's minor unit with MoneyFromFloat. CaptureFunds set to manual only authorizes the
+// amount.
 //
 // Customer Messages: None
-// Errors: None
+// Errors: ErrRequiredArgumentMissing
 // Verifications: None
 func (ai2cClientPtr *Ai2CClient) AI2PaymentRequest(ai2CPaymentInfo Ai2CPaymentInfo) (
 	reply []byte,
@@ -275,378 +380,662 @@ func (ai2cClientPtr *Ai2CClient) AI2PaymentRequest(ai2CPaymentInfo Ai2CPaymentIn
 ) {
 
 	var (
-		tReply *nats.Msg
+		ctx                = context.Background()
+		tAmount            Money
+		tCancelResult      CancelResult
+		tCaptureRequest    CapturePaymentIntentRequest
+		tKey               string
+		tPaymentIntent     PaymentIntent
+		tPaymentIntentList PaymentIntentList
+		tPaymentMethodList PaymentMethodList
 	)
 
 	if ai2CPaymentInfo.Keys.Public == ctv.VAL_EMPTY && ai2CPaymentInfo.Keys.Secret == ctv.VAL_EMPTY {
 		errorInfo = pi.NewErrorInfo(pi.ErrRequiredArgumentMissing, fmt.Sprintf("%v and %v %v", ctv.TXT_PUBLIC_KEY, ctv.TXT_SECRET_KEY, ctv.TXT_ARE_MISSING))
 		return
 	}
+	tKey = getSaaSKey(ai2CPaymentInfo.Keys)
 
 	// Determine Request
 	//
 	// Request is a cancellation
 	if len(ai2CPaymentInfo.CancellationReason) > ctv.VAL_ZERO && len(ai2CPaymentInfo.PaymentIntentId) > ctv.VAL_ZERO {
-		tReply, errorInfo = processCancelPaymentIntent(
-			ai2cClientPtr.styhCustomerConfig.clientId, ai2cClientPtr.secretKey, ai2cClientPtr.styhCustomerConfig.username, &ai2cClientPtr.natsService,
-			ai2CPaymentInfo,
+		tCancelResult, errorInfo = ai2cClientPtr.CancelPaymentIntent(
+			ctx,
+			CancelPaymentIntentRequest{
+				SaaSKey:            tKey,
+				PaymentIntentId:    ai2CPaymentInfo.PaymentIntentId,
+				CancellationReason: ai2CPaymentInfo.CancellationReason,
+			},
 		)
-		reply = tReply.Data
+		reply = tCancelResult.Raw
 		return
 	}
 	// Request is to list payment intents
 	if ai2CPaymentInfo.ReturnRecordsLimit > ctv.VAL_ZERO {
-		tReply, errorInfo = processListPaymentIntent(
-			ai2cClientPtr.styhCustomerConfig.clientId, ai2cClientPtr.secretKey, ai2cClientPtr.styhCustomerConfig.username,
-			&ai2cClientPtr.natsService, ai2CPaymentInfo,
+		tPaymentIntentList, errorInfo = ai2cClientPtr.ListPaymentIntents(
+			ctx,
+			ListPaymentIntentRequest{
+				SaaSKey:       tKey,
+				CustomerId:    ai2CPaymentInfo.CustomerId,
+				Limit:         ai2CPaymentInfo.ReturnRecordsLimit,
+				StartingAfter: ai2CPaymentInfo.StartingAfterRecord,
+			},
 		)
-		reply = tReply.Data
+		reply = tPaymentIntentList.Raw
 		return
 	}
 	// Request is to list payment methods
 	if strings.ToLower(ai2CPaymentInfo.PaymentMethod) == ctv.PAYMENT_METHOD_LIST {
-		tReply, errorInfo = processListPaymentMethod(
-			ai2cClientPtr.styhCustomerConfig.clientId,
-			ai2cClientPtr.secretKey,
-			ai2cClientPtr.styhCustomerConfig.username,
-			&ai2cClientPtr.natsService,
-			ai2CPaymentInfo,
+		tPaymentMethodList, errorInfo = ai2cClientPtr.ListPaymentMethods(
+			ctx,
+			ListPaymentMethodRequest{
+				SaaSKey: tKey,
+			},
 		)
-		reply = tReply.Data
+		reply = tPaymentMethodList.Raw
+		return
+	}
+	// Request is to capture a payment
+	if len(ai2CPaymentInfo.PaymentIntentId) > ctv.VAL_ZERO && len(ai2CPaymentInfo.CaptureFunds) > ctv.VAL_ZERO {
+		tCaptureRequest.SaaSKey = tKey
+		tCaptureRequest.PaymentIntentId = ai2CPaymentInfo.PaymentIntentId
+		if ai2CPaymentInfo.Amount > 0 {
+			if tAmount, errorInfo = MoneyFromFloat(ai2CPaymentInfo.Amount, ai2CPaymentInfo.Currency); errorInfo.Error != nil {
+				return
+			}
+			tCaptureRequest.AmountToCapture = &tAmount
+		}
+		tPaymentIntent, errorInfo = ai2cClientPtr.CapturePaymentIntent(ctx, tCaptureRequest)
+		reply = tPaymentIntent.Raw
+		return
+	}
+	// Request is to confirm a payment
+	if len(ai2CPaymentInfo.PaymentIntentId) > ctv.VAL_ZERO && len(ai2CPaymentInfo.PaymentMethod) > ctv.VAL_ZERO {
+		tPaymentIntent, errorInfo = ai2cClientPtr.ConfirmPaymentIntent(
+			ctx,
+			ConfirmPaymentIntentRequest{
+				SaaSKey:         tKey,
+				PaymentIntentId: ai2CPaymentInfo.PaymentIntentId,
+				PaymentMethodId: ai2CPaymentInfo.PaymentMethod,
+				ReturnURL:       ai2CPaymentInfo.ReturnURL,
+			},
+		)
+		reply = tPaymentIntent.Raw
 		return
 	}
 	// Request is to create a payment
 	if ai2CPaymentInfo.Amount > 0 && len(ai2CPaymentInfo.Currency) > ctv.VAL_ZERO {
-		tReply, errorInfo = processCreatePaymentIntent(
-			ai2cClientPtr.styhCustomerConfig.clientId,
-			ai2cClientPtr.secretKey,
-			ai2cClientPtr.styhCustomerConfig.username,
-			&ai2cClientPtr.natsService,
-			ai2CPaymentInfo,
+		if tAmount, errorInfo = MoneyFromFloat(ai2CPaymentInfo.Amount, ai2CPaymentInfo.Currency); errorInfo.Error != nil {
+			return
+		}
+		tPaymentIntent, errorInfo = ai2cClientPtr.CreatePaymentIntent(
+			ctx,
+			PaymentIntentRequest{
+				Amount:                  tAmount,
+				AutomaticPaymentMethods: ai2CPaymentInfo.UseAutomaticPaymentMethod,
+				CaptureMethod:           ai2CPaymentInfo.CaptureFunds,
+				CustomerId:              ai2CPaymentInfo.CustomerId,
+				Description:             ai2CPaymentInfo.Description,
+				ReceiptEmail:            ai2CPaymentInfo.ReceiptEmail,
+				ReturnURL:               ai2CPaymentInfo.ReturnURL,
+				SaaSKey:                 tKey,
+			},
 		)
-		reply = tReply.Data
+		reply = tPaymentIntent.Raw
 		return
 	}
-	// // Request is to confirm a payment
-	// if ai2CPaymentInfo.Amount > 0 && len(ai2CPaymentInfo.Currency) > ctv.VAL_ZERO && len(ai2CPaymentInfo.PaymentIntentId) > ctv.VAL_ZERO {
-	// 	tReply, errorInfo = (ai2cClientPtr.clientId, &ai2cClientPtr.natsService, ai2CPaymentInfo)
-	// 	reply = tReply.Data
-	// 	return
-	// }
+	errorInfo = pi.NewErrorInfo(pi.ErrRequiredArgumentMissing, TXT_UNDETERMINED_PAYMENT_REQUEST)
 
 	return
 }
 
-// Private Function below here
-
-// processAWSClientParameters - handles getting and storing the shared AWS SSM Parameters.
+// CancelPaymentIntent - cancels the payment intent identified by PaymentIntentId. The SaaSKey, PaymentIntentId,
+// and CancellationReason are required.
 //
-//	Customer Messages: None
-//	Errors: None
-//	Verifications: None
-func processAWSClientParameters(
-	awsSettings awss.AWSSettings,
-	idToken string,
-	environment string,
-	natsConfigPtr *ns.NATSConfiguration,
-) (errorInfo pi.ErrorInfo) {
-
-	var (
-		tParameterName    string
-		tParametersOutput awsSSM.GetParametersOutput
-		tParameterValue   string
-	)
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) CancelPaymentIntent(ctx context.Context, request CancelPaymentIntentRequest) (
+	cancelResult CancelResult,
+	errorInfo pi.ErrorInfo,
+) {
 
-	if tParametersOutput, errorInfo = awss.GetParameters(
-		awsSettings.STYHCognitoIdentityInfo,
-		awsSettings.BaseConfig,
-		idToken,
-		ctv.GetParameterName(AI2C_SSM_PARAMETER_PREFIX, environment, ctv.PARAMETER_NATS_TOKEN),
-		ctv.GetParameterName(AI2C_SSM_PARAMETER_PREFIX, environment, ctv.PARAMETER_NATS_PORT),
-		ctv.GetParameterName(AI2C_SSM_PARAMETER_PREFIX, environment, ctv.PARAMETER_NATS_URL),
-		ctv.GetParameterName(AI2C_SSM_PARAMETER_PREFIX, environment, ctv.PARAMETER_TLS_CERT),
-		ctv.GetParameterName(AI2C_SSM_PARAMETER_PREFIX, environment, ctv.PARAMETER_TLS_PRIVATE_KEY),
-		ctv.GetParameterName(AI2C_SSM_PARAMETER_PREFIX, environment, ctv.PARAMETER_TLS_CA_BUNDLE),
-	); errorInfo.Error != nil {
-		pi.PrintErrorInfo(errorInfo)
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if request.PaymentIntentId == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_PAYMENT_INTENT_ID)
+		return
+	}
+	if request.CancellationReason == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_CANCELLATION_REASON)
 		return
 	}
 
-	for _, parameter := range tParametersOutput.Parameters {
-		tParameterName = *parameter.Name
-		tParameterValue = *parameter.Value
-		switch tParameterName {
-		case ctv.GetParameterName(AI2C_SSM_PARAMETER_PREFIX, environment, ctv.PARAMETER_NATS_TOKEN):
-			natsConfigPtr.NATSToken = tParameterValue
-		case ctv.GetParameterName(AI2C_SSM_PARAMETER_PREFIX, environment, ctv.PARAMETER_NATS_PORT):
-			natsConfigPtr.NATSPort, _ = strconv.Atoi(tParameterValue)
-		case ctv.GetParameterName(AI2C_SSM_PARAMETER_PREFIX, environment, ctv.PARAMETER_NATS_URL):
-			natsConfigPtr.NATSURL = tParameterValue
-		case ctv.GetParameterName(AI2C_SSM_PARAMETER_PREFIX, environment, ctv.PARAMETER_TLS_CERT):
-			natsConfigPtr.NATSTLSInfo.TLSCert = tParameterValue
-		case ctv.GetParameterName(AI2C_SSM_PARAMETER_PREFIX, environment, ctv.PARAMETER_TLS_PRIVATE_KEY):
-			natsConfigPtr.NATSTLSInfo.TLSPrivateKey = tParameterValue
-		case ctv.GetParameterName(AI2C_SSM_PARAMETER_PREFIX, environment, ctv.PARAMETER_TLS_CA_BUNDLE):
-			natsConfigPtr.NATSTLSInfo.TLSCABundle = tParameterValue
-		default:
-			// Optional: Handle unknown parameter names (log a warning?)
+	errorInfo = ai2cClientPtr.processRequest(ctx, ctv.SUB_STRIPE_CANCEL_PAYMENT_INTENT, request, &cancelResult)
+
+	return
+}
+
+// CapturePaymentIntent - captures a payment intent created with CAPTURE_METHOD_MANUAL and returns the updated
+// payment intent. The SaaSKey and PaymentIntentId are required. When AmountToCapture is set, it must be greater than
+// zero and only that amount is captured. Otherwise, the full amount is captured.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing, ErrAmountNotPositive, ErrCurrencyInvalid
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) CapturePaymentIntent(ctx context.Context, request CapturePaymentIntentRequest) (
+	paymentIntent PaymentIntent,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if request.PaymentIntentId == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_PAYMENT_INTENT_ID)
+		return
+	}
+	if request.AmountToCapture != nil {
+		if errorInfo = validateAmount(*request.AmountToCapture); errorInfo.Error != nil {
+			return
 		}
 	}
 
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_CAPTURE_PAYMENT_INTENT, request, &paymentIntent)
+
 	return
 }
 
-// processCancelPaymentIntent - handles cancelling a payment intent by sending a request to the NATS service.
-// CancellationReason in ai2CPaymentInfo specifies the reason for cancellation.
-// The SaaSKey and PaymentIntentId in ai2CPaymentInfo are used to identify the payment intent to cancel.
-// If ai2CPaymentInfo.Keys.Public is empty, ai2CPaymentInfo.Keys.Secret is used as the SaaSKey.
-// The cancellation request is serialized to JSON and sent as a NATS message with appropriate headers.
+// ConfirmPaymentIntent - confirms the payment intent identified by PaymentIntentId with the payment method and
+// returns the updated payment intent. The SaaSKey, PaymentIntentId, and PaymentMethodId are required. When the
+// customer must authenticate, for example with 3-D Secure, the status is requires_action and NextAction holds
+// what the customer must do. Use RequiresAction and NextAction.RedirectURL to handle it.
 //
-//	Customer Messages: None
-//	Errors: None
-//	Verifications: None
-func processCancelPaymentIntent(
-	clientId, secretKey, username string,
-	natsServicePtr *ns.NATSService,
-	ai2CPaymentInfo Ai2CPaymentInfo,
-) (
-	reply *nats.Msg,
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) ConfirmPaymentIntent(ctx context.Context, request ConfirmPaymentIntentRequest) (
+	paymentIntent PaymentIntent,
 	errorInfo pi.ErrorInfo,
 ) {
 
-	var (
-		tEncryptedRequestData string
-		tFunction, _, _, _    = runtime.Caller(0)
-		tFunctionName         = runtime.FuncForPC(tFunction).Name()
-		tKey                  string
-		tNATSHeader           = make(map[string][]string)
-		tPIR                  CancelPaymentIntentRequest
-		tRequestData          []byte
-		tRequestMsg           nats.Msg
-	)
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if request.PaymentIntentId == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_PAYMENT_INTENT_ID)
+		return
+	}
+	if request.PaymentMethodId == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_PAYMENT_METHOD)
+		return
+	}
 
-	if ai2CPaymentInfo.Keys.Public == ctv.VAL_EMPTY {
-		tKey = ai2CPaymentInfo.Keys.Secret
-	} else {
-		tKey = ai2CPaymentInfo.Keys.Public
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_CONFIRM_PAYMENT_INTENT, request, &paymentIntent)
+
+	return
+}
+
+// CreatePaymentIntent - creates a payment intent. The SaaSKey and a positive Amount with its currency are required.
+// When Confirm is set, PaymentMethodId is required and the reply is handled the same way as ConfirmPaymentIntent.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing, ErrAmountNotPositive, ErrCurrencyInvalid, ErrCaptureMethodInvalid
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) CreatePaymentIntent(ctx context.Context, request PaymentIntentRequest) (
+	paymentIntent PaymentIntent,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
 	}
+	if errorInfo = validateAmount(request.Amount); errorInfo.Error != nil {
+		return
+	}
+	if request.CaptureMethod != ctv.VAL_EMPTY && request.CaptureMethod != CAPTURE_METHOD_AUTOMATIC && request.CaptureMethod != CAPTURE_METHOD_MANUAL {
+		errorInfo = pi.NewErrorInfo(ErrCaptureMethodInvalid, fmt.Sprintf("%v%v", TXT_CAPTURE_METHOD, request.CaptureMethod))
+		return
+	}
+	if request.Confirm && request.PaymentMethodId == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_PAYMENT_METHOD)
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, ctv.SUB_STRIPE_CREATE_PAYMENT_INTENT, request, &paymentIntent)
+
+	return
+}
+
+// GetPaymentIntent - returns the payment intent identified by PaymentIntentId. The SaaSKey and PaymentIntentId are
+// required.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) GetPaymentIntent(ctx context.Context, request GetPaymentIntentRequest) (
+	paymentIntent PaymentIntent,
+	errorInfo pi.ErrorInfo,
+) {
 
-	tPIR = CancelPaymentIntentRequest{
-		SaaSKey:            tKey,
-		PaymentIntentId:    ai2CPaymentInfo.PaymentIntentId,
-		CancellationReason: ai2CPaymentInfo.CancellationReason,
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
 	}
-	if tRequestData, errorInfo.Error = json.Marshal(tPIR); errorInfo.Error != nil {
-		errorInfo = pi.NewErrorInfo(errorInfo.Error, fmt.Sprintf("%v%v - %v%v", ctv.TXT_FUNCTION_NAME, tFunctionName, ctv.TXT_SUBJECT, ctv.SUB_STRIPE_CANCEL_PAYMENT_INTENT))
+	if request.PaymentIntentId == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_PAYMENT_INTENT_ID)
 		return
 	}
-	if tEncryptedRequestData, errorInfo = jwts.Encrypt(clientId, secretKey, string(tRequestData)); errorInfo.Error != nil {
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_GET_PAYMENT_INTENT, request, &paymentIntent)
+
+	return
+}
+
+// ListPaymentIntents - lists payment intents. The SaaSKey is required and the Limit must be set to a value
+// between 1 and 100. Providing the CustomerId will only return payments for that customer. StartingAfter is
+// the id of the payment intent the list starts after.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing, ErrLimitOutOfRange
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) ListPaymentIntents(ctx context.Context, request ListPaymentIntentRequest) (
+	paymentIntentList PaymentIntentList,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if errorInfo = validateListLimit(request.Limit); errorInfo.Error != nil {
 		return
 	}
 
-	tNATSHeader[ctv.FN_STYH_CLIENT_ID] = []string{clientId}
-	tNATSHeader[ctv.FN_USERNAME] = []string{username}
+	errorInfo = ai2cClientPtr.processRequest(ctx, ctv.SUB_STRIPE_LIST_PAYMENT_INTENTS, request, &paymentIntentList)
 
-	tRequestMsg = nats.Msg{
-		Subject: ctv.SUB_STRIPE_CANCEL_PAYMENT_INTENT,
-		Header:  tNATSHeader,
-		Data:    []byte(tEncryptedRequestData),
+	return
+}
+
+// ListPaymentMethods - lists the payment methods available to the SaaS account. The SaaSKey is required.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) ListPaymentMethods(ctx context.Context, request ListPaymentMethodRequest) (
+	paymentMethodList PaymentMethodList,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
 	}
 
-	reply, errorInfo = ns.RequestWithHeader(natsServicePtr.ConnPtr, natsServicePtr.InstanceName, &tRequestMsg, 2*time.Second)
+	errorInfo = ai2cClientPtr.processRequest(ctx, ctv.SUB_STRIPE_LIST_PAYMENT_METHODS, request, &paymentMethodList)
 
 	return
 }
 
-// processCreatePaymentIntent - handles creating a payment intent request and sending it to the NATS service.
+// UpdatePaymentIntent - changes the payment intent identified by PaymentIntentId and returns the updated payment
+// intent. The SaaSKey, PaymentIntentId, and at least one change are required. When Amount is set, it must be
+// greater than zero and use a valid currency.
 //
 // Customer Messages: None
-// Errors: None
+// Errors: ErrRequiredArgumentMissing, ErrNoChanges, ErrAmountNotPositive, ErrCurrencyInvalid
 // Verifications: None
-func processCreatePaymentIntent(
-	clientId, secretKey, username string,
-	natsServicePtr *ns.NATSService,
-	ai2CPaymentInfo Ai2CPaymentInfo,
-) (
-	reply *nats.Msg,
+func (ai2cClientPtr *Ai2CClient) UpdatePaymentIntent(ctx context.Context, request UpdatePaymentIntentRequest) (
+	paymentIntent PaymentIntent,
 	errorInfo pi.ErrorInfo,
 ) {
 
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if request.PaymentIntentId == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_PAYMENT_INTENT_ID)
+		return
+	}
+	if request.Amount == nil && request.Description == nil && request.ReceiptEmail == nil && len(request.Metadata) == ctv.VAL_ZERO {
+		errorInfo = pi.NewErrorInfo(ErrNoChanges, fmt.Sprintf("%v%v", TXT_PAYMENT_INTENT, request.PaymentIntentId))
+		return
+	}
+	if request.Amount != nil {
+		if errorInfo = validateAmount(*request.Amount); errorInfo.Error != nil {
+			return
+		}
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_UPDATE_PAYMENT_INTENT, request, &paymentIntent)
+
+	return
+}
+
+// MarshalJSON - encodes the request with AmountToCapture as a decimal in the major unit and the currency next to it.
+// Both are left out when AmountToCapture is nil.
+//
+// Customer Messages: None
+// Errors: json errors
+// Verifications: None
+func (request CapturePaymentIntentRequest) MarshalJSON() ([]byte, error) {
+
+	type tCapturePaymentIntentRequest CapturePaymentIntentRequest
+
 	var (
-		tEncryptedRequestData string
-		tFunction, _, _, _    = runtime.Caller(0)
-		tFunctionName         = runtime.FuncForPC(tFunction).Name()
-		tKey                  string
-		tNATSHeader           = make(map[string][]string)
-		tPIR                  PaymentIntentRequest
-		tRequestData          []byte
-		tRequestMsg           nats.Msg
+		tAmountToCapture json.Number
+		tCurrency        string
 	)
 
-	if ai2CPaymentInfo.Keys.Public == ctv.VAL_EMPTY {
-		tKey = ai2CPaymentInfo.Keys.Secret
-	} else {
-		tKey = ai2CPaymentInfo.Keys.Public
-	}
+	tAmountToCapture, tCurrency = decimalAmount(request.AmountToCapture)
+
+	return json.Marshal(struct {
+		tCapturePaymentIntentRequest
+		AmountToCapture json.Number `json:"amount_to_capture,omitempty"`
+		Currency        string      `json:"currency,omitempty"`
+	}{
+		tCapturePaymentIntentRequest: tCapturePaymentIntentRequest(request),
+		AmountToCapture:              tAmountToCapture,
+		Currency:                     tCurrency,
+	})
+}
 
-	tPIR = PaymentIntentRequest{
-		Amount:                  ai2CPaymentInfo.Amount,
-		AutomaticPaymentMethods: ai2CPaymentInfo.UseAutomaticPaymentMethod,
-		Currency:                ai2CPaymentInfo.Currency,
-		Description:             ai2CPaymentInfo.Description,
-		ReceiptEmail:            ai2CPaymentInfo.ReceiptEmail,
-		ReturnURL:               ai2CPaymentInfo.ReturnURL,
-		SaaSKey:                 tKey,
-	}
+// MarshalJSON - encodes the request with Amount as a decimal in the major unit and the currency next to it.
+//
+// Customer Messages: None
+// Errors: json errors
+// Verifications: None
+func (request PaymentIntentRequest) MarshalJSON() ([]byte, error) {
+
+	type tPaymentIntentRequest PaymentIntentRequest
+
+	return json.Marshal(struct {
+		tPaymentIntentRequest
+		Amount   json.Number `json:"amount"`
+		Currency string      `json:"currency"`
+	}{
+		tPaymentIntentRequest: tPaymentIntentRequest(request),
+		Amount:                json.Number(request.Amount.Decimal()),
+		Currency:              request.Amount.Currency,
+	})
+}
 
-	if tRequestData, errorInfo.Error = json.Marshal(tPIR); errorInfo.Error != nil {
-		errorInfo = pi.NewErrorInfo(errorInfo.Error, fmt.Sprintf("%v%v - %v%v", ctv.TXT_FUNCTION_NAME, tFunctionName, ctv.TXT_SUBJECT, ctv.SUB_STRIPE_CREATE_PAYMENT_INTENT))
+// MarshalJSON - encodes the request with Amount as a decimal in the major unit and the currency next to it. Both
+// are left out when Amount is nil.
+//
+// Customer Messages: None
+// Errors: json errors
+// Verifications: None
+func (request UpdatePaymentIntentRequest) MarshalJSON() ([]byte, error) {
+
+	type tUpdatePaymentIntentRequest UpdatePaymentIntentRequest
+
+	var (
+		tAmount   json.Number
+		tCurrency string
+	)
+
+	tAmount, tCurrency = decimalAmount(request.Amount)
+
+	return json.Marshal(struct {
+		tUpdatePaymentIntentRequest
+		Amount   json.Number `json:"amount,omitempty"`
+		Currency string      `json:"currency,omitempty"`
+	}{
+		tUpdatePaymentIntentRequest: tUpdatePaymentIntentRequest(request),
+		Amount:                      tAmount,
+		Currency:                    tCurrency,
+	})
+}
+
+// Private Function below here
+
+// buildTemporaryFiles - writes the credential, certificate, and key files into tempDirectory and stores their names
+// in natsConfigPtr. Existing files are replaced.
+//
+//	Customer Messages: None
+//	Errors: Errors returned by ns.BuildTemporaryFiles and jwts.BuildTLSTemporaryFiles
+//	Verifications: None
+func buildTemporaryFiles(tempDirectory string, natsConfigPtr *ns.NATSConfiguration) (errorInfo pi.ErrorInfo) {
+
+	if errorInfo = ns.BuildTemporaryFiles(tempDirectory, *natsConfigPtr); errorInfo.Error != nil {
 		return
 	}
-	if tEncryptedRequestData, errorInfo = jwts.Encrypt(clientId, secretKey, string(tRequestData)); errorInfo.Error != nil {
+	natsConfigPtr.NATSCredentialsFilename = fmt.Sprintf("%v/%v", tempDirectory, ns.CREDENTIAL_FILENAME)
+
+	if errorInfo = jwts.BuildTLSTemporaryFiles(tempDirectory, natsConfigPtr.NATSTLSInfo); errorInfo.Error != nil {
 		return
 	}
+	natsConfigPtr.NATSTLSInfo.TLSCABundleFQN = fmt.Sprintf("%v/%v", tempDirectory, jwts.TLS_CA_BUNDLE_FILENAME)
+	natsConfigPtr.NATSTLSInfo.TLSCertFQN = fmt.Sprintf("%v/%v", tempDirectory, jwts.TLS_CERT_FILENAME)
+	natsConfigPtr.NATSTLSInfo.TLSPrivateKeyFQN = fmt.Sprintf("%v/%v", tempDirectory, jwts.TLS_PRIVATE_KEY_FILENAME)
 
-	tNATSHeader[ctv.FN_STYH_CLIENT_ID] = []string{clientId}
-	tNATSHeader[ctv.FN_USERNAME] = []string{username}
+	return
+}
 
-	tRequestMsg = nats.Msg{
-		Subject: ctv.SUB_STRIPE_CREATE_PAYMENT_INTENT,
-		Header:  tNATSHeader,
-		Data:    []byte(tEncryptedRequestData),
+// connect - gets the NATS and TLS parameters, writes the temporary files unless the credentials are kept in memory,
+// and connects to the NATS service. When no parameter source is set, it logs into AWS Cognito and uses AWS SSM.
+//
+//	Customer Messages: None
+//	Errors: Errors returned by the token manager, processClientParameters, and getConnection
+//	Verifications: None
+func (ai2cClientPtr *Ai2CClient) connect(configPtr *clientConfig, passwordPtr *string) (errorInfo pi.ErrorInfo) {
+
+	// AWS Cognito is only needed to read the parameters from AWS SSM.
+	if configPtr.parameterSource == nil {
+		if ai2cClientPtr.awsSettings, errorInfo = awss.LoadAWSCustomerSettings(configPtr.environment); errorInfo.Error != nil {
+			return
+		}
+		// This returns information about the STYH Customer
+		if ai2cClientPtr.tokenManagerPtr, errorInfo = newTokenManager(
+			ai2cClientPtr.awsSettings, configPtr.username, *passwordPtr, configPtr.tokenRefreshMargin, configPtr.tokenHooks,
+		); errorInfo.Error != nil {
+			return
+		}
+		configPtr.parameterSource = ssmParameterSource{
+			awsSettings:     ai2cClientPtr.awsSettings,
+			tokenManagerPtr: ai2cClientPtr.tokenManagerPtr,
+		}
+		ai2cClientPtr.tokenManagerPtr.onRenew = ai2cClientPtr.reconnect
+	}
+	ai2cClientPtr.parameterSource = configPtr.parameterSource
+	if errorInfo = processClientParameters(context.Background(), ai2cClientPtr.parameterSource, ai2cClientPtr.environment, &ai2cClientPtr.natsConfig); errorInfo.Error != nil {
+		return
 	}
 
-	reply, errorInfo = ns.RequestWithHeader(natsServicePtr.ConnPtr, natsServicePtr.InstanceName, &tRequestMsg, 2*time.Second)
+	if ai2cClientPtr.inMemoryCredentials == false {
+		if errorInfo = buildTemporaryFiles(ai2cClientPtr.tempDirectory, &ai2cClientPtr.natsConfig); errorInfo.Error != nil {
+			return
+		}
+	}
+
+	ai2cClientPtr.natsService.ConnPtr, errorInfo = getConnection(
+		ai2cClientPtr.natsService.InstanceName, ai2cClientPtr.natsConfig, ai2cClientPtr.inMemoryCredentials, ai2cClientPtr.natsOptions,
+	)
 
 	return
 }
 
-// processListPaymentIntent - sends a NATS request to list payment intents based on the provided criteria.
-// The ReturnRecordsLimit must be set to a value between 1 and 100.
+// getConnection - connects to the NATS service. When inMemory is true, the TLS configuration and user credentials
+// are built from the values in natsConfig, otherwise the credentials and TLS files are used. The natsOptions are
+// applied last.
 //
 //	Customer Messages: None
-//	Errors: None
+//	Errors: ErrTLSCABundleInvalid, tls errors, nkeys errors, nats errors
 //	Verifications: None
-func processListPaymentIntent(
-	clientId, secretKey, username string,
-	natsServicePtr *ns.NATSService,
-	ai2CPaymentInfo Ai2CPaymentInfo,
-) (
-	reply *nats.Msg,
+func getConnection(instanceName string, natsConfig ns.NATSConfiguration, inMemory bool, natsOptions []nats.Option) (
+	connPtr *nats.Conn,
 	errorInfo pi.ErrorInfo,
 ) {
 
 	var (
-		tEncryptedRequestData string
-		tFunction, _, _, _    = runtime.Caller(0)
-		tFunctionName         = runtime.FuncForPC(tFunction).Name()
-		tKey                  string
-		tNATSHeader           = make(map[string][]string)
-		tPIR                  ListPaymentIntentRequest
-		tRequestData          []byte
-		tRequestMsg           nats.Msg
+		tCredentialOptions []nats.Option
+		tOptions           = []nats.Option{nats.Name(instanceName)}
+		tURL               = fmt.Sprintf("tls://%v:%v", natsConfig.NATSURL, natsConfig.NATSPort)
 	)
 
-	if ai2CPaymentInfo.Keys.Public == ctv.VAL_EMPTY {
-		tKey = ai2CPaymentInfo.Keys.Secret
+	if inMemory {
+		if tCredentialOptions, errorInfo = buildInMemoryOptions(natsConfig); errorInfo.Error != nil {
+			return
+		}
 	} else {
-		tKey = ai2CPaymentInfo.Keys.Public
+		tCredentialOptions = []nats.Option{
+			nats.UserCredentials(natsConfig.NATSCredentialsFilename),
+			nats.RootCAs(natsConfig.NATSTLSInfo.TLSCABundleFQN),
+			nats.ClientCert(natsConfig.NATSTLSInfo.TLSCertFQN, natsConfig.NATSTLSInfo.TLSPrivateKeyFQN),
+		}
 	}
+	tOptions = append(tOptions, tCredentialOptions...)
+	tOptions = append(tOptions, natsOptions...)
 
-	tPIR = ListPaymentIntentRequest{
-		SaaSKey:       tKey,
-		CustomerId:    ai2CPaymentInfo.CustomerId,
-		Limit:         ai2CPaymentInfo.ReturnRecordsLimit,
-		StartingAfter: ai2CPaymentInfo.StartingAfterRecord,
-	}
-	if tRequestData, errorInfo.Error = json.Marshal(tPIR); errorInfo.Error != nil {
-		errorInfo = pi.NewErrorInfo(errorInfo.Error, fmt.Sprintf("%v%v - %v%v", ctv.TXT_FUNCTION_NAME, tFunctionName, ctv.TXT_SUBJECT, ctv.SUB_STRIPE_LIST_PAYMENT_INTENTS))
+	if connPtr, errorInfo.Error = nats.Connect(tURL, tOptions...); errorInfo.Error != nil {
+		errorInfo = pi.NewErrorInfo(errorInfo.Error, fmt.Sprintf("%v%v", TXT_NATS_URL, tURL))
 		return
 	}
-	if tEncryptedRequestData, errorInfo = jwts.Encrypt(clientId, secretKey, string(tRequestData)); errorInfo.Error != nil {
-		return
+
+	return
+}
+
+// getRequestTimeout - returns the client's request timeout, or DEFAULT_REQUEST_TIMEOUT when it is not set.
+//
+//	Customer Messages: None
+//	Errors: None
+//	Verifications: None
+func (ai2cClientPtr *Ai2CClient) getRequestTimeout() (timeout time.Duration) {
+
+	if ai2cClientPtr.requestTimeout <= 0 {
+		return DEFAULT_REQUEST_TIMEOUT
 	}
 
-	tNATSHeader[ctv.FN_STYH_CLIENT_ID] = []string{clientId}
-	tNATSHeader[ctv.FN_USERNAME] = []string{username}
+	return ai2cClientPtr.requestTimeout
+}
 
-	tRequestMsg = nats.Msg{
-		Subject: ctv.SUB_STRIPE_LIST_PAYMENT_INTENTS,
-		Header:  tNATSHeader,
-		Data:    []byte(tEncryptedRequestData),
+// getSaaSKey - returns the public key, or the secret key when the public key is empty.
+//
+//	Customer Messages: None
+//	Errors: None
+//	Verifications: None
+func getSaaSKey(keys SaaSKeys) (key string) {
+
+	if keys.Public == ctv.VAL_EMPTY {
+		return keys.Secret
 	}
 
-	reply, errorInfo = ns.RequestWithHeader(natsServicePtr.ConnPtr, natsServicePtr.InstanceName, &tRequestMsg, 2*time.Second)
+	return keys.Public
+}
 
-	return
+// missingParameter - builds the error returned when a required request field is empty.
+//
+//	Customer Messages: None
+//	Errors: ErrRequiredArgumentMissing
+//	Verifications: None
+func missingParameter(fieldName string) (errorInfo pi.ErrorInfo) {
+
+	return pi.NewErrorInfo(pi.ErrRequiredArgumentMissing, fmt.Sprintf("%v%v", ctv.TXT_MISSING_PARAMETER, fieldName))
 }
 
-// processListPaymentMethod - sends a request to list payment methods to the NATS service using the Stripe
-// subject. It marshals the request payload and adds the client ID to the request header.
+// printErrorInfo - outputs the error using the client's logger or, when there isn't one, pi.PrintErrorInfo.
 //
 //	Customer Messages: None
 //	Errors: None
 //	Verifications: None
-func processListPaymentMethod(
-	clientId, secretKey, username string,
-	natsServicePtr *ns.NATSService,
-	ai2CPaymentInfo Ai2CPaymentInfo,
+func (ai2cClientPtr *Ai2CClient) printErrorInfo(errorInfo pi.ErrorInfo) {
+
+	if ai2cClientPtr.logger == nil {
+		pi.PrintErrorInfo(errorInfo)
+		return
+	}
+
+	ai2cClientPtr.logger.Printf("%+v", errorInfo)
+}
+
+// processRequest - marshals the request, encrypts it with the client's secret key, and sends it to the NATS
+// service on the subject. The client id and username are added to the request header. The reply is decoded
+// into replyPtr. When ctx has no deadline, the client's request timeout is applied.
+//
+//	Customer Messages: None
+//	Errors: ErrClientClosed, ErrReplyError, context.DeadlineExceeded, context.Canceled, nats errors
+//	Verifications: None
+func (ai2cClientPtr *Ai2CClient) processRequest(
+	ctx context.Context,
+	subject string,
+	request interface{},
+	replyPtr interface{},
 ) (
-	reply *nats.Msg,
 	errorInfo pi.ErrorInfo,
 ) {
 
 	var (
+		tCancel               context.CancelFunc
+		tConnInFlightPtr      *sync.WaitGroup
+		tConnPtr              *nats.Conn
 		tEncryptedRequestData string
 		tFunction, _, _, _    = runtime.Caller(0)
 		tFunctionName         = runtime.FuncForPC(tFunction).Name()
-		tKey                  string
 		tNATSHeader           = make(map[string][]string)
-		tPIR                  ListPaymentMethodRequest
+		tReplyMsg             *nats.Msg
 		tRequestData          []byte
 		tRequestMsg           nats.Msg
 	)
 
-	if ai2CPaymentInfo.Keys.Public == ctv.VAL_EMPTY {
-		tKey = ai2CPaymentInfo.Keys.Secret
-	} else {
-		tKey = ai2CPaymentInfo.Keys.Public
+	if tConnPtr, tConnInFlightPtr, errorInfo = ai2cClientPtr.beginRequest(); errorInfo.Error != nil {
+		return
 	}
+	defer ai2cClientPtr.endRequest(tConnInFlightPtr)
 
-	tPIR = ListPaymentMethodRequest{
-		SaaSKey: tKey,
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if _, ok := ctx.Deadline(); ok == false {
+		ctx, tCancel = context.WithTimeout(ctx, ai2cClientPtr.getRequestTimeout())
+		defer tCancel()
 	}
-	if tRequestData, errorInfo.Error = json.Marshal(tPIR); errorInfo.Error != nil {
-		errorInfo = pi.NewErrorInfo(errorInfo.Error, fmt.Sprintf("%v%v - %v%v", ctv.TXT_FUNCTION_NAME, tFunctionName, ctv.TXT_SUBJECT, ctv.SUB_STRIPE_LIST_PAYMENT_METHODS))
+
+	if tRequestData, errorInfo.Error = json.Marshal(request); errorInfo.Error != nil {
+		errorInfo = pi.NewErrorInfo(errorInfo.Error, fmt.Sprintf("%v%v - %v%v", ctv.TXT_FUNCTION_NAME, tFunctionName, ctv.TXT_SUBJECT, subject))
 		return
 	}
-	if tEncryptedRequestData, errorInfo = jwts.Encrypt(clientId, secretKey, string(tRequestData)); errorInfo.Error != nil {
+	if tEncryptedRequestData, errorInfo = jwts.Encrypt(ai2cClientPtr.styhCustomerConfig.clientId, ai2cClientPtr.secretKey, string(tRequestData)); errorInfo.Error != nil {
 		return
 	}
 
-	tNATSHeader[ctv.FN_STYH_CLIENT_ID] = []string{clientId}
-	tNATSHeader[ctv.FN_USERNAME] = []string{username}
+	tNATSHeader[ctv.FN_STYH_CLIENT_ID] = []string{ai2cClientPtr.styhCustomerConfig.clientId}
+	tNATSHeader[ctv.FN_USERNAME] = []string{ai2cClientPtr.styhCustomerConfig.username}
 
 	tRequestMsg = nats.Msg{
-		Subject: ctv.SUB_STRIPE_LIST_PAYMENT_METHODS,
+		Subject: subject,
 		Header:  tNATSHeader,
 		Data:    []byte(tEncryptedRequestData),
 	}
 
-	reply, errorInfo = ns.RequestWithHeader(natsServicePtr.ConnPtr, natsServicePtr.InstanceName, &tRequestMsg, 2*time.Second)
+	if tReplyMsg, errorInfo.Error = tConnPtr.RequestMsgWithContext(ctx, &tRequestMsg); errorInfo.Error != nil {
+		errorInfo = pi.NewErrorInfo(errorInfo.Error, fmt.Sprintf("%v%v - %v%v", ctv.TXT_FUNCTION_NAME, tFunctionName, ctv.TXT_SUBJECT, subject))
+		return
+	}
+
+	errorInfo = decodeReply(subject, tReplyMsg.Data, replyPtr)
 
 	return
 }
 
 // validateConfiguration - checks the values in the configuration file are valid. ValidateConfiguration doesn't
-// test if the configuration file exists, readable, or parsable.
+// test if the configuration file exists, readable, or parsable. The password is only required when cognitoLogin is
+// set, because no AWS Cognito login is done with WithNATSConnection.
 //
 //	Customer Messages: None
-//	Errors: ErrEnvironmentInvalid, ErrRequiredArgumentMissing
+//	Errors: ErrEnvironmentInvalid, ErrRequiredArgumentMissing, ErrTimeoutInvalid
 //	Verifications: None
 func validateConfiguration(
-	styhClientId, environment, secretKey, tempDirectory, username string,
+	styhClientId, environment, secretKey, username string,
 	passwordPtr *string,
+	cognitoLogin bool,
+	requestTimeout time.Duration,
 ) (
 	errorInfo pi.ErrorInfo,
 ) {
@@ -659,7 +1048,7 @@ func validateConfiguration(
 		errorInfo = pi.NewErrorInfo(pi.ErrEnvironmentInvalid, fmt.Sprintf("%v%v", ctv.TXT_EVIRONMENT, ctv.FN_ENVIRONMENT))
 		return
 	}
-	if passwordPtr == nil {
+	if cognitoLogin && (passwordPtr == nil || *passwordPtr == ctv.VAL_EMPTY) {
 		errorInfo = pi.NewErrorInfo(pi.ErrRequiredArgumentMissing, fmt.Sprintf("%v%v", ctv.TXT_MISSING_PARAMETER, ctv.FN_PASSWORD))
 		return
 	}
@@ -667,14 +1056,63 @@ func validateConfiguration(
 		errorInfo = pi.NewErrorInfo(pi.ErrRequiredArgumentMissing, fmt.Sprintf("%v%v", ctv.TXT_MISSING_PARAMETER, ctv.FN_SECRET_KEY))
 		return
 	}
-	if tempDirectory == ctv.VAL_EMPTY {
-		errorInfo = pi.NewErrorInfo(pi.ErrRequiredArgumentMissing, fmt.Sprintf("%v%v", ctv.TXT_MISSING_PARAMETER, ctv.FN_TEMP_DIRECTORY))
-		return
-	}
 	if username == ctv.VAL_EMPTY {
 		errorInfo = pi.NewErrorInfo(pi.ErrRequiredArgumentMissing, fmt.Sprintf("%v%v", ctv.TXT_MISSING_PARAMETER, ctv.FN_USERNAME))
 		return
 	}
+	if requestTimeout < 0 {
+		errorInfo = pi.NewErrorInfo(ErrTimeoutInvalid, fmt.Sprintf("%v%v", TXT_REQUEST_TIMEOUT, requestTimeout))
+		return
+	}
+
+	return
+}
+
+// validateDateRange - returns ErrDateRangeInvalid when a bound is negative or the range ends before it starts. A nil
+// range is valid.
+//
+//	Customer Messages: None
+//	Errors: ErrDateRangeInvalid
+//	Verifications: None
+func validateDateRange(dateRangePtr *DateRange) (errorInfo pi.ErrorInfo) {
+
+	var (
+		tStart int64
+		tEnd   int64
+	)
+
+	if dateRangePtr == nil {
+		return
+	}
+
+	if dateRangePtr.Gt < 0 || dateRangePtr.Gte < 0 || dateRangePtr.Lt < 0 || dateRangePtr.Lte < 0 {
+		errorInfo = pi.NewErrorInfo(ErrDateRangeInvalid, fmt.Sprintf("%v%+v", TXT_DATE_RANGE, *dateRangePtr))
+		return
+	}
+	tStart = max(dateRangePtr.Gt+1, dateRangePtr.Gte)
+	if dateRangePtr.Lt > 0 {
+		tEnd = dateRangePtr.Lt - 1
+	}
+	if dateRangePtr.Lte > 0 && (tEnd == 0 || dateRangePtr.Lte < tEnd) {
+		tEnd = dateRangePtr.Lte
+	}
+	if tEnd > 0 && tStart > tEnd {
+		errorInfo = pi.NewErrorInfo(ErrDateRangeInvalid, fmt.Sprintf("%v%+v", TXT_DATE_RANGE, *dateRangePtr))
+	}
+
+	return
+}
+
+// validateListLimit - returns ErrLimitOutOfRange unless the limit is between LIST_LIMIT_MIN and LIST_LIMIT_MAX.
+//
+//	Customer Messages: None
+//	Errors: ErrLimitOutOfRange
+//	Verifications: None
+func validateListLimit(limit int64) (errorInfo pi.ErrorInfo) {
+
+	if limit < LIST_LIMIT_MIN || limit > LIST_LIMIT_MAX {
+		errorInfo = pi.NewErrorInfo(ErrLimitOutOfRange, fmt.Sprintf("%v%v", TXT_LIMIT, limit))
+	}
 
 	return
 }
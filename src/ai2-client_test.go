@@ -0,0 +1,96 @@
+package src
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	pi "github.com/sty-holdings/sty-shared/v2024/programInfo"
+)
+
+func TestValidateConfiguration(t *testing.T) {
+
+	var (
+		tEmpty    = ""
+		tPassword = "password"
+	)
+
+	tests := []struct {
+		name           string
+		environment    string
+		passwordPtr    *string
+		cognitoLogin   bool
+		requestTimeout time.Duration
+		wantErr        error
+	}{
+		{name: "valid", environment: "development", passwordPtr: &tPassword, cognitoLogin: true},
+		{name: "invalid environment", environment: "staging", passwordPtr: &tPassword, cognitoLogin: true, wantErr: pi.ErrEnvironmentInvalid},
+		{name: "empty password", environment: "development", passwordPtr: &tEmpty, cognitoLogin: true, wantErr: pi.ErrRequiredArgumentMissing},
+		{name: "no password", environment: "development", cognitoLogin: true, wantErr: pi.ErrRequiredArgumentMissing},
+		{name: "empty password without a login", environment: "development", passwordPtr: &tEmpty},
+		{name: "negative timeout", environment: "development", passwordPtr: &tPassword, cognitoLogin: true, requestTimeout: -time.Second, wantErr: ErrTimeoutInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errorInfo := validateConfiguration("client", tt.environment, "secret", "username", tt.passwordPtr, tt.cognitoLogin, tt.requestTimeout)
+			if errors.Is(errorInfo.Error, tt.wantErr) == false {
+				t.Errorf("validateConfiguration() error = %v, want %v", errorInfo.Error, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateListLimit(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		limit   int64
+		wantErr error
+	}{
+		{name: "minimum", limit: LIST_LIMIT_MIN},
+		{name: "maximum", limit: LIST_LIMIT_MAX},
+		{name: "between", limit: 10},
+		{name: "zero", limit: 0, wantErr: ErrLimitOutOfRange},
+		{name: "negative", limit: -1, wantErr: ErrLimitOutOfRange},
+		{name: "above the maximum", limit: LIST_LIMIT_MAX + 1, wantErr: ErrLimitOutOfRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if errorInfo := validateListLimit(tt.limit); errors.Is(errorInfo.Error, tt.wantErr) == false {
+				t.Errorf("validateListLimit(%v) error = %v, want %v", tt.limit, errorInfo.Error, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateDateRange(t *testing.T) {
+
+	tests := []struct {
+		name      string
+		dateRange *DateRange
+		wantErr   error
+	}{
+		{name: "nil", dateRange: nil},
+		{name: "empty", dateRange: &DateRange{}},
+		{name: "only a start", dateRange: &DateRange{Gte: 1700000000}},
+		{name: "only an end", dateRange: &DateRange{Lt: 1700000000}},
+		{name: "start before end", dateRange: &DateRange{Gte: 1700000000, Lt: 1800000000}},
+		{name: "single second", dateRange: &DateRange{Gte: 1700000000, Lte: 1700000000}},
+		{name: "exclusive bounds one apart", dateRange: &DateRange{Gt: 1700000000, Lt: 1700000001}, wantErr: ErrDateRangeInvalid},
+		{name: "exclusive bounds two apart", dateRange: &DateRange{Gt: 1700000000, Lt: 1700000002}},
+		{name: "the tighter end is used", dateRange: &DateRange{Gte: 1700000000, Lt: 1800000000, Lte: 1600000000}, wantErr: ErrDateRangeInvalid},
+		{name: "the tighter start is used", dateRange: &DateRange{Gt: 1800000000, Gte: 1600000000, Lte: 1700000000}, wantErr: ErrDateRangeInvalid},
+		{name: "end before start", dateRange: &DateRange{Gte: 1800000000, Lte: 1700000000}, wantErr: ErrDateRangeInvalid},
+		{name: "negative bound", dateRange: &DateRange{Gte: -1}, wantErr: ErrDateRangeInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if errorInfo := validateDateRange(tt.dateRange); errors.Is(errorInfo.Error, tt.wantErr) == false {
+				t.Errorf("validateDateRange(%+v) error = %v, want %v", tt.dateRange, errorInfo.Error, tt.wantErr)
+			}
+		})
+	}
+}
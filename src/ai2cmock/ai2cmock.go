@@ -0,0 +1,1802 @@
+// Package ai2cmock
+/*
+This is a mock of src.PaymentClient for testing code that uses an Ai2CClient.
+
+RESTRICTIONS:
+	The Func fields must be set before the mock is used by more than one goroutine.
+
+NOTES:
+    Each operation has a Func field that is called with the arguments the operation receives. When the Func field
+    is not set, the operation returns the zero reply and ErrNotProgrammed. Every call is recorded, whether or not
+    the Func field is set, and can be inspected with Calls and CallCount.
+
+    Example:
+        mockPtr := &ai2cmock.PaymentClient{
+            CreatePaymentIntentFunc: func(ctx context.Context, request src.PaymentIntentRequest) (src.PaymentIntent, pi.ErrorInfo) {
+                return src.PaymentIntent{Id: "pi_123", Status: src.PAYMENT_INTENT_STATUS_SUCCEEDED}, pi.ErrorInfo{}
+            },
+        }
+
+COPYRIGHT:
+	Copyright 2022
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+
+*/
+package ai2cmock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"ai2c-go-client/src"
+	pi "github.com/sty-holdings/sty-shared/v2024/programInfo"
+)
+
+//goland:noinspection ALL
+const (
+	METHOD_AI2_PAYMENT_REQUEST           = "AI2PaymentRequest"
+	METHOD_ARCHIVE_PRICE                 = "ArchivePrice"
+	METHOD_ARCHIVE_PRODUCT               = "ArchiveProduct"
+	METHOD_ATTACH_PAYMENT_METHOD         = "AttachPaymentMethod"
+	METHOD_CANCEL_PAYMENT_INTENT         = "CancelPaymentIntent"
+	METHOD_CANCEL_REFUND                 = "CancelRefund"
+	METHOD_CANCEL_SETUP_INTENT           = "CancelSetupIntent"
+	METHOD_CANCEL_SUBSCRIPTION           = "CancelSubscription"
+	METHOD_CAPTURE_PAYMENT_INTENT        = "CapturePaymentIntent"
+	METHOD_CLOSE_DISPUTE                 = "CloseDispute"
+	METHOD_CONFIRM_PAYMENT_INTENT        = "ConfirmPaymentIntent"
+	METHOD_CONFIRM_SETUP_INTENT          = "ConfirmSetupIntent"
+	METHOD_CREATE_CHECKOUT_SESSION       = "CreateCheckoutSession"
+	METHOD_CREATE_COUPON                 = "CreateCoupon"
+	METHOD_CREATE_CUSTOMER               = "CreateCustomer"
+	METHOD_CREATE_INVOICE                = "CreateInvoice"
+	METHOD_CREATE_INVOICE_ITEM           = "CreateInvoiceItem"
+	METHOD_CREATE_PAYMENT_INTENT         = "CreatePaymentIntent"
+	METHOD_CREATE_PAYMENT_LINK           = "CreatePaymentLink"
+	METHOD_CREATE_PAYOUT                 = "CreatePayout"
+	METHOD_CREATE_PRICE                  = "CreatePrice"
+	METHOD_CREATE_PRODUCT                = "CreateProduct"
+	METHOD_CREATE_PROMOTION_CODE         = "CreatePromotionCode"
+	METHOD_CREATE_REFUND                 = "CreateRefund"
+	METHOD_CREATE_SETUP_INTENT           = "CreateSetupIntent"
+	METHOD_CREATE_SUBSCRIPTION           = "CreateSubscription"
+	METHOD_DEACTIVATE_PAYMENT_LINK       = "DeactivatePaymentLink"
+	METHOD_DEACTIVATE_PROMOTION_CODE     = "DeactivatePromotionCode"
+	METHOD_DELETE_COUPON                 = "DeleteCoupon"
+	METHOD_DELETE_CUSTOMER               = "DeleteCustomer"
+	METHOD_DELETE_INVOICE_ITEM           = "DeleteInvoiceItem"
+	METHOD_DETACH_PAYMENT_METHOD         = "DetachPaymentMethod"
+	METHOD_EXPIRE_CHECKOUT_SESSION       = "ExpireCheckoutSession"
+	METHOD_FINALIZE_INVOICE              = "FinalizeInvoice"
+	METHOD_GET_BALANCE                   = "GetBalance"
+	METHOD_GET_CHECKOUT_SESSION          = "GetCheckoutSession"
+	METHOD_GET_COUPON                    = "GetCoupon"
+	METHOD_GET_CUSTOMER                  = "GetCustomer"
+	METHOD_GET_DISPUTE                   = "GetDispute"
+	METHOD_GET_INVOICE                   = "GetInvoice"
+	METHOD_GET_PAYMENT_INTENT            = "GetPaymentIntent"
+	METHOD_GET_PAYOUT                    = "GetPayout"
+	METHOD_GET_PRICE                     = "GetPrice"
+	METHOD_GET_PRODUCT                   = "GetProduct"
+	METHOD_GET_PROMOTION_CODE            = "GetPromotionCode"
+	METHOD_GET_REFUND                    = "GetRefund"
+	METHOD_GET_SETUP_INTENT              = "GetSetupIntent"
+	METHOD_GET_SUBSCRIPTION              = "GetSubscription"
+	METHOD_LIST_BALANCE_TRANSACTIONS     = "ListBalanceTransactions"
+	METHOD_LIST_COUPONS                  = "ListCoupons"
+	METHOD_LIST_CUSTOMERS                = "ListCustomers"
+	METHOD_LIST_CUSTOMER_PAYMENT_METHODS = "ListCustomerPaymentMethods"
+	METHOD_LIST_DISPUTES                 = "ListDisputes"
+	METHOD_LIST_INVOICES                 = "ListInvoices"
+	METHOD_LIST_INVOICE_ITEMS            = "ListInvoiceItems"
+	METHOD_LIST_PAYMENT_INTENTS          = "ListPaymentIntents"
+	METHOD_LIST_PAYMENT_LINKS            = "ListPaymentLinks"
+	METHOD_LIST_PAYMENT_METHODS          = "ListPaymentMethods"
+	METHOD_LIST_PAYOUTS                  = "ListPayouts"
+	METHOD_LIST_PRICES                   = "ListPrices"
+	METHOD_LIST_PRODUCTS                 = "ListProducts"
+	METHOD_LIST_PROMOTION_CODES          = "ListPromotionCodes"
+	METHOD_LIST_REFUNDS                  = "ListRefunds"
+	METHOD_LIST_SETUP_INTENTS            = "ListSetupIntents"
+	METHOD_LIST_SUBSCRIPTIONS            = "ListSubscriptions"
+	METHOD_MARK_INVOICE_UNCOLLECTIBLE    = "MarkInvoiceUncollectible"
+	METHOD_PAY_INVOICE                   = "PayInvoice"
+	METHOD_SEND_INVOICE                  = "SendInvoice"
+	METHOD_SET_DEFAULT_PAYMENT_METHOD    = "SetDefaultPaymentMethod"
+	METHOD_UPDATE_COUPON                 = "UpdateCoupon"
+	METHOD_UPDATE_CUSTOMER               = "UpdateCustomer"
+	METHOD_UPDATE_DISPUTE                = "UpdateDispute"
+	METHOD_UPDATE_PAYMENT_INTENT         = "UpdatePaymentIntent"
+	METHOD_UPDATE_PAYMENT_LINK           = "UpdatePaymentLink"
+	METHOD_UPDATE_PRICE                  = "UpdatePrice"
+	METHOD_UPDATE_PRODUCT                = "UpdateProduct"
+	METHOD_UPDATE_PROMOTION_CODE         = "UpdatePromotionCode"
+	METHOD_UPDATE_SUBSCRIPTION           = "UpdateSubscription"
+	METHOD_VOID_INVOICE                  = "VoidInvoice"
+)
+
+//goland:noinspection ALL
+const (
+	TXT_METHOD = "Method: "
+)
+
+var (
+	ErrNotProgrammed = errors.New("the mock has no reply programmed for the method")
+)
+
+// Call - a recorded call. Request holds the request argument, which is the Ai2CPaymentInfo for AI2PaymentRequest.
+type Call struct {
+	Method  string
+	Request interface{}
+}
+
+// PaymentClient - a mock of src.PaymentClient. The zero value is ready to use.
+type PaymentClient struct {
+	AI2PaymentRequestFunc          func(ai2CPaymentInfo src.Ai2CPaymentInfo) ([]byte, pi.ErrorInfo)
+	ArchivePriceFunc               func(ctx context.Context, request src.ArchivePriceRequest) (src.Price, pi.ErrorInfo)
+	ArchiveProductFunc             func(ctx context.Context, request src.ArchiveProductRequest) (src.Product, pi.ErrorInfo)
+	AttachPaymentMethodFunc        func(ctx context.Context, request src.AttachPaymentMethodRequest) (src.PaymentMethod, pi.ErrorInfo)
+	CancelPaymentIntentFunc        func(ctx context.Context, request src.CancelPaymentIntentRequest) (src.CancelResult, pi.ErrorInfo)
+	CancelRefundFunc               func(ctx context.Context, request src.CancelRefundRequest) (src.Refund, pi.ErrorInfo)
+	CancelSetupIntentFunc          func(ctx context.Context, request src.CancelSetupIntentRequest) (src.SetupIntent, pi.ErrorInfo)
+	CancelSubscriptionFunc         func(ctx context.Context, request src.CancelSubscriptionRequest) (src.Subscription, pi.ErrorInfo)
+	CapturePaymentIntentFunc       func(ctx context.Context, request src.CapturePaymentIntentRequest) (src.PaymentIntent, pi.ErrorInfo)
+	CloseDisputeFunc               func(ctx context.Context, request src.CloseDisputeRequest) (src.Dispute, pi.ErrorInfo)
+	ConfirmPaymentIntentFunc       func(ctx context.Context, request src.ConfirmPaymentIntentRequest) (src.PaymentIntent, pi.ErrorInfo)
+	ConfirmSetupIntentFunc         func(ctx context.Context, request src.ConfirmSetupIntentRequest) (src.SetupIntent, pi.ErrorInfo)
+	CreateCheckoutSessionFunc      func(ctx context.Context, request src.CreateCheckoutSessionRequest) (src.CheckoutSession, pi.ErrorInfo)
+	CreateCouponFunc               func(ctx context.Context, request src.CreateCouponRequest) (src.Coupon, pi.ErrorInfo)
+	CreateCustomerFunc             func(ctx context.Context, request src.CreateCustomerRequest) (src.Customer, pi.ErrorInfo)
+	CreateInvoiceFunc              func(ctx context.Context, request src.CreateInvoiceRequest) (src.Invoice, pi.ErrorInfo)
+	CreateInvoiceItemFunc          func(ctx context.Context, request src.CreateInvoiceItemRequest) (src.InvoiceItem, pi.ErrorInfo)
+	CreatePaymentIntentFunc        func(ctx context.Context, request src.PaymentIntentRequest) (src.PaymentIntent, pi.ErrorInfo)
+	CreatePaymentLinkFunc          func(ctx context.Context, request src.CreatePaymentLinkRequest) (src.PaymentLink, pi.ErrorInfo)
+	CreatePayoutFunc               func(ctx context.Context, request src.CreatePayoutRequest) (src.Payout, pi.ErrorInfo)
+	CreatePriceFunc                func(ctx context.Context, request src.CreatePriceRequest) (src.Price, pi.ErrorInfo)
+	CreateProductFunc              func(ctx context.Context, request src.CreateProductRequest) (src.Product, pi.ErrorInfo)
+	CreatePromotionCodeFunc        func(ctx context.Context, request src.CreatePromotionCodeRequest) (src.PromotionCode, pi.ErrorInfo)
+	CreateRefundFunc               func(ctx context.Context, request src.CreateRefundRequest) (src.Refund, pi.ErrorInfo)
+	CreateSetupIntentFunc          func(ctx context.Context, request src.CreateSetupIntentRequest) (src.SetupIntent, pi.ErrorInfo)
+	CreateSubscriptionFunc         func(ctx context.Context, request src.CreateSubscriptionRequest) (src.Subscription, pi.ErrorInfo)
+	DeactivatePaymentLinkFunc      func(ctx context.Context, request src.DeactivatePaymentLinkRequest) (src.PaymentLink, pi.ErrorInfo)
+	DeactivatePromotionCodeFunc    func(ctx context.Context, request src.DeactivatePromotionCodeRequest) (src.PromotionCode, pi.ErrorInfo)
+	DeleteCouponFunc               func(ctx context.Context, request src.DeleteCouponRequest) (src.DeletedResult, pi.ErrorInfo)
+	DeleteCustomerFunc             func(ctx context.Context, request src.DeleteCustomerRequest) (src.DeletedResult, pi.ErrorInfo)
+	DeleteInvoiceItemFunc          func(ctx context.Context, request src.DeleteInvoiceItemRequest) (src.DeletedResult, pi.ErrorInfo)
+	DetachPaymentMethodFunc        func(ctx context.Context, request src.DetachPaymentMethodRequest) (src.PaymentMethod, pi.ErrorInfo)
+	ExpireCheckoutSessionFunc      func(ctx context.Context, request src.ExpireCheckoutSessionRequest) (src.CheckoutSession, pi.ErrorInfo)
+	FinalizeInvoiceFunc            func(ctx context.Context, request src.FinalizeInvoiceRequest) (src.Invoice, pi.ErrorInfo)
+	GetBalanceFunc                 func(ctx context.Context, request src.GetBalanceRequest) (src.Balance, pi.ErrorInfo)
+	GetCheckoutSessionFunc         func(ctx context.Context, request src.GetCheckoutSessionRequest) (src.CheckoutSession, pi.ErrorInfo)
+	GetCouponFunc                  func(ctx context.Context, request src.GetCouponRequest) (src.Coupon, pi.ErrorInfo)
+	GetCustomerFunc                func(ctx context.Context, request src.GetCustomerRequest) (src.Customer, pi.ErrorInfo)
+	GetDisputeFunc                 func(ctx context.Context, request src.GetDisputeRequest) (src.Dispute, pi.ErrorInfo)
+	GetInvoiceFunc                 func(ctx context.Context, request src.GetInvoiceRequest) (src.Invoice, pi.ErrorInfo)
+	GetPaymentIntentFunc           func(ctx context.Context, request src.GetPaymentIntentRequest) (src.PaymentIntent, pi.ErrorInfo)
+	GetPayoutFunc                  func(ctx context.Context, request src.GetPayoutRequest) (src.Payout, pi.ErrorInfo)
+	GetPriceFunc                   func(ctx context.Context, request src.GetPriceRequest) (src.Price, pi.ErrorInfo)
+	GetProductFunc                 func(ctx context.Context, request src.GetProductRequest) (src.Product, pi.ErrorInfo)
+	GetPromotionCodeFunc           func(ctx context.Context, request src.GetPromotionCodeRequest) (src.PromotionCode, pi.ErrorInfo)
+	GetRefundFunc                  func(ctx context.Context, request src.GetRefundRequest) (src.Refund, pi.ErrorInfo)
+	GetSetupIntentFunc             func(ctx context.Context, request src.GetSetupIntentRequest) (src.SetupIntent, pi.ErrorInfo)
+	GetSubscriptionFunc            func(ctx context.Context, request src.GetSubscriptionRequest) (src.Subscription, pi.ErrorInfo)
+	ListBalanceTransactionsFunc    func(ctx context.Context, request src.ListBalanceTransactionsRequest) (src.BalanceTransactionList, pi.ErrorInfo)
+	ListCouponsFunc                func(ctx context.Context, request src.ListCouponsRequest) (src.CouponList, pi.ErrorInfo)
+	ListCustomerPaymentMethodsFunc func(ctx context.Context, request src.ListCustomerPaymentMethodsRequest) (src.PaymentMethodList, pi.ErrorInfo)
+	ListCustomersFunc              func(ctx context.Context, request src.ListCustomersRequest) (src.CustomerList, pi.ErrorInfo)
+	ListDisputesFunc               func(ctx context.Context, request src.ListDisputesRequest) (src.DisputeList, pi.ErrorInfo)
+	ListInvoiceItemsFunc           func(ctx context.Context, request src.ListInvoiceItemsRequest) (src.InvoiceItemList, pi.ErrorInfo)
+	ListInvoicesFunc               func(ctx context.Context, request src.ListInvoicesRequest) (src.InvoiceList, pi.ErrorInfo)
+	ListPaymentIntentsFunc         func(ctx context.Context, request src.ListPaymentIntentRequest) (src.PaymentIntentList, pi.ErrorInfo)
+	ListPaymentLinksFunc           func(ctx context.Context, request src.ListPaymentLinksRequest) (src.PaymentLinkList, pi.ErrorInfo)
+	ListPaymentMethodsFunc         func(ctx context.Context, request src.ListPaymentMethodRequest) (src.PaymentMethodList, pi.ErrorInfo)
+	ListPayoutsFunc                func(ctx context.Context, request src.ListPayoutsRequest) (src.PayoutList, pi.ErrorInfo)
+	ListPricesFunc                 func(ctx context.Context, request src.ListPricesRequest) (src.PriceList, pi.ErrorInfo)
+	ListProductsFunc               func(ctx context.Context, request src.ListProductsRequest) (src.ProductList, pi.ErrorInfo)
+	ListPromotionCodesFunc         func(ctx context.Context, request src.ListPromotionCodesRequest) (src.PromotionCodeList, pi.ErrorInfo)
+	ListRefundsFunc                func(ctx context.Context, request src.ListRefundsRequest) (src.RefundList, pi.ErrorInfo)
+	ListSetupIntentsFunc           func(ctx context.Context, request src.ListSetupIntentsRequest) (src.SetupIntentList, pi.ErrorInfo)
+	ListSubscriptionsFunc          func(ctx context.Context, request src.ListSubscriptionsRequest) (src.SubscriptionList, pi.ErrorInfo)
+	MarkInvoiceUncollectibleFunc   func(ctx context.Context, request src.MarkInvoiceUncollectibleRequest) (src.Invoice, pi.ErrorInfo)
+	PayInvoiceFunc                 func(ctx context.Context, request src.PayInvoiceRequest) (src.Invoice, pi.ErrorInfo)
+	SendInvoiceFunc                func(ctx context.Context, request src.SendInvoiceRequest) (src.Invoice, pi.ErrorInfo)
+	SetDefaultPaymentMethodFunc    func(ctx context.Context, request src.SetDefaultPaymentMethodRequest) (src.Customer, pi.ErrorInfo)
+	UpdateCouponFunc               func(ctx context.Context, request src.UpdateCouponRequest) (src.Coupon, pi.ErrorInfo)
+	UpdateCustomerFunc             func(ctx context.Context, request src.UpdateCustomerRequest) (src.Customer, pi.ErrorInfo)
+	UpdateDisputeFunc              func(ctx context.Context, request src.UpdateDisputeRequest) (src.Dispute, pi.ErrorInfo)
+	UpdatePaymentIntentFunc        func(ctx context.Context, request src.UpdatePaymentIntentRequest) (src.PaymentIntent, pi.ErrorInfo)
+	UpdatePaymentLinkFunc          func(ctx context.Context, request src.UpdatePaymentLinkRequest) (src.PaymentLink, pi.ErrorInfo)
+	UpdatePriceFunc                func(ctx context.Context, request src.UpdatePriceRequest) (src.Price, pi.ErrorInfo)
+	UpdateProductFunc              func(ctx context.Context, request src.UpdateProductRequest) (src.Product, pi.ErrorInfo)
+	UpdatePromotionCodeFunc        func(ctx context.Context, request src.UpdatePromotionCodeRequest) (src.PromotionCode, pi.ErrorInfo)
+	UpdateSubscriptionFunc         func(ctx context.Context, request src.UpdateSubscriptionRequest) (src.Subscription, pi.ErrorInfo)
+	VoidInvoiceFunc                func(ctx context.Context, request src.VoidInvoiceRequest) (src.Invoice, pi.ErrorInfo)
+
+	calls []Call
+	lock  sync.Mutex
+}
+
+var _ src.PaymentClient = (*PaymentClient)(nil)
+
+// AI2PaymentRequest - records the call and returns the reply from AI2PaymentRequestFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by AI2PaymentRequestFunc
+// Verifications: None
+func (mockPtr *PaymentClient) AI2PaymentRequest(ai2CPaymentInfo src.Ai2CPaymentInfo) (
+	reply []byte,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_AI2_PAYMENT_REQUEST, ai2CPaymentInfo)
+	if mockPtr.AI2PaymentRequestFunc == nil {
+		errorInfo = notProgrammed(METHOD_AI2_PAYMENT_REQUEST)
+		return
+	}
+
+	return mockPtr.AI2PaymentRequestFunc(ai2CPaymentInfo)
+}
+
+// ArchivePrice - records the call and returns the reply from ArchivePriceFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by ArchivePriceFunc
+// Verifications: None
+func (mockPtr *PaymentClient) ArchivePrice(ctx context.Context, request src.ArchivePriceRequest) (
+	price src.Price,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_ARCHIVE_PRICE, request)
+	if mockPtr.ArchivePriceFunc == nil {
+		errorInfo = notProgrammed(METHOD_ARCHIVE_PRICE)
+		return
+	}
+
+	return mockPtr.ArchivePriceFunc(ctx, request)
+}
+
+// ArchiveProduct - records the call and returns the reply from ArchiveProductFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by ArchiveProductFunc
+// Verifications: None
+func (mockPtr *PaymentClient) ArchiveProduct(ctx context.Context, request src.ArchiveProductRequest) (
+	product src.Product,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_ARCHIVE_PRODUCT, request)
+	if mockPtr.ArchiveProductFunc == nil {
+		errorInfo = notProgrammed(METHOD_ARCHIVE_PRODUCT)
+		return
+	}
+
+	return mockPtr.ArchiveProductFunc(ctx, request)
+}
+
+// AttachPaymentMethod - records the call and returns the reply from AttachPaymentMethodFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by AttachPaymentMethodFunc
+// Verifications: None
+func (mockPtr *PaymentClient) AttachPaymentMethod(ctx context.Context, request src.AttachPaymentMethodRequest) (
+	paymentMethod src.PaymentMethod,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_ATTACH_PAYMENT_METHOD, request)
+	if mockPtr.AttachPaymentMethodFunc == nil {
+		errorInfo = notProgrammed(METHOD_ATTACH_PAYMENT_METHOD)
+		return
+	}
+
+	return mockPtr.AttachPaymentMethodFunc(ctx, request)
+}
+
+// CallCount - returns the number of calls recorded for the method.
+//
+// Customer Messages: None
+// Errors: None
+// Verifications: None
+func (mockPtr *PaymentClient) CallCount(method string) (count int) {
+
+	mockPtr.lock.Lock()
+	defer mockPtr.lock.Unlock()
+
+	for _, call := range mockPtr.calls {
+		if call.Method == method {
+			count++
+		}
+	}
+
+	return
+}
+
+// Calls - returns a copy of the recorded calls in the order they were made.
+//
+// Customer Messages: None
+// Errors: None
+// Verifications: None
+func (mockPtr *PaymentClient) Calls() (calls []Call) {
+
+	mockPtr.lock.Lock()
+	defer mockPtr.lock.Unlock()
+
+	return append([]Call(nil), mockPtr.calls...)
+}
+
+// CancelPaymentIntent - records the call and returns the reply from CancelPaymentIntentFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by CancelPaymentIntentFunc
+// Verifications: None
+func (mockPtr *PaymentClient) CancelPaymentIntent(ctx context.Context, request src.CancelPaymentIntentRequest) (
+	cancelResult src.CancelResult,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_CANCEL_PAYMENT_INTENT, request)
+	if mockPtr.CancelPaymentIntentFunc == nil {
+		errorInfo = notProgrammed(METHOD_CANCEL_PAYMENT_INTENT)
+		return
+	}
+
+	return mockPtr.CancelPaymentIntentFunc(ctx, request)
+}
+
+// CancelRefund - records the call and returns the reply from CancelRefundFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by CancelRefundFunc
+// Verifications: None
+func (mockPtr *PaymentClient) CancelRefund(ctx context.Context, request src.CancelRefundRequest) (
+	refund src.Refund,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_CANCEL_REFUND, request)
+	if mockPtr.CancelRefundFunc == nil {
+		errorInfo = notProgrammed(METHOD_CANCEL_REFUND)
+		return
+	}
+
+	return mockPtr.CancelRefundFunc(ctx, request)
+}
+
+// CancelSetupIntent - records the call and returns the reply from CancelSetupIntentFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by CancelSetupIntentFunc
+// Verifications: None
+func (mockPtr *PaymentClient) CancelSetupIntent(ctx context.Context, request src.CancelSetupIntentRequest) (
+	setupIntent src.SetupIntent,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_CANCEL_SETUP_INTENT, request)
+	if mockPtr.CancelSetupIntentFunc == nil {
+		errorInfo = notProgrammed(METHOD_CANCEL_SETUP_INTENT)
+		return
+	}
+
+	return mockPtr.CancelSetupIntentFunc(ctx, request)
+}
+
+// CancelSubscription - records the call and returns the reply from CancelSubscriptionFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by CancelSubscriptionFunc
+// Verifications: None
+func (mockPtr *PaymentClient) CancelSubscription(ctx context.Context, request src.CancelSubscriptionRequest) (
+	subscription src.Subscription,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_CANCEL_SUBSCRIPTION, request)
+	if mockPtr.CancelSubscriptionFunc == nil {
+		errorInfo = notProgrammed(METHOD_CANCEL_SUBSCRIPTION)
+		return
+	}
+
+	return mockPtr.CancelSubscriptionFunc(ctx, request)
+}
+
+// CapturePaymentIntent - records the call and returns the reply from CapturePaymentIntentFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by CapturePaymentIntentFunc
+// Verifications: None
+func (mockPtr *PaymentClient) CapturePaymentIntent(ctx context.Context, request src.CapturePaymentIntentRequest) (
+	paymentIntent src.PaymentIntent,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_CAPTURE_PAYMENT_INTENT, request)
+	if mockPtr.CapturePaymentIntentFunc == nil {
+		errorInfo = notProgrammed(METHOD_CAPTURE_PAYMENT_INTENT)
+		return
+	}
+
+	return mockPtr.CapturePaymentIntentFunc(ctx, request)
+}
+
+// CloseDispute - records the call and returns the reply from CloseDisputeFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by CloseDisputeFunc
+// Verifications: None
+func (mockPtr *PaymentClient) CloseDispute(ctx context.Context, request src.CloseDisputeRequest) (
+	dispute src.Dispute,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_CLOSE_DISPUTE, request)
+	if mockPtr.CloseDisputeFunc == nil {
+		errorInfo = notProgrammed(METHOD_CLOSE_DISPUTE)
+		return
+	}
+
+	return mockPtr.CloseDisputeFunc(ctx, request)
+}
+
+// ConfirmPaymentIntent - records the call and returns the reply from ConfirmPaymentIntentFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by ConfirmPaymentIntentFunc
+// Verifications: None
+func (mockPtr *PaymentClient) ConfirmPaymentIntent(ctx context.Context, request src.ConfirmPaymentIntentRequest) (
+	paymentIntent src.PaymentIntent,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_CONFIRM_PAYMENT_INTENT, request)
+	if mockPtr.ConfirmPaymentIntentFunc == nil {
+		errorInfo = notProgrammed(METHOD_CONFIRM_PAYMENT_INTENT)
+		return
+	}
+
+	return mockPtr.ConfirmPaymentIntentFunc(ctx, request)
+}
+
+// ConfirmSetupIntent - records the call and returns the reply from ConfirmSetupIntentFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by ConfirmSetupIntentFunc
+// Verifications: None
+func (mockPtr *PaymentClient) ConfirmSetupIntent(ctx context.Context, request src.ConfirmSetupIntentRequest) (
+	setupIntent src.SetupIntent,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_CONFIRM_SETUP_INTENT, request)
+	if mockPtr.ConfirmSetupIntentFunc == nil {
+		errorInfo = notProgrammed(METHOD_CONFIRM_SETUP_INTENT)
+		return
+	}
+
+	return mockPtr.ConfirmSetupIntentFunc(ctx, request)
+}
+
+// CreateCheckoutSession - records the call and returns the reply from CreateCheckoutSessionFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by CreateCheckoutSessionFunc
+// Verifications: None
+func (mockPtr *PaymentClient) CreateCheckoutSession(ctx context.Context, request src.CreateCheckoutSessionRequest) (
+	checkoutSession src.CheckoutSession,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_CREATE_CHECKOUT_SESSION, request)
+	if mockPtr.CreateCheckoutSessionFunc == nil {
+		errorInfo = notProgrammed(METHOD_CREATE_CHECKOUT_SESSION)
+		return
+	}
+
+	return mockPtr.CreateCheckoutSessionFunc(ctx, request)
+}
+
+// CreateCoupon - records the call and returns the reply from CreateCouponFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by CreateCouponFunc
+// Verifications: None
+func (mockPtr *PaymentClient) CreateCoupon(ctx context.Context, request src.CreateCouponRequest) (
+	coupon src.Coupon,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_CREATE_COUPON, request)
+	if mockPtr.CreateCouponFunc == nil {
+		errorInfo = notProgrammed(METHOD_CREATE_COUPON)
+		return
+	}
+
+	return mockPtr.CreateCouponFunc(ctx, request)
+}
+
+// CreateCustomer - records the call and returns the reply from CreateCustomerFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by CreateCustomerFunc
+// Verifications: None
+func (mockPtr *PaymentClient) CreateCustomer(ctx context.Context, request src.CreateCustomerRequest) (
+	customer src.Customer,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_CREATE_CUSTOMER, request)
+	if mockPtr.CreateCustomerFunc == nil {
+		errorInfo = notProgrammed(METHOD_CREATE_CUSTOMER)
+		return
+	}
+
+	return mockPtr.CreateCustomerFunc(ctx, request)
+}
+
+// CreateInvoice - records the call and returns the reply from CreateInvoiceFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by CreateInvoiceFunc
+// Verifications: None
+func (mockPtr *PaymentClient) CreateInvoice(ctx context.Context, request src.CreateInvoiceRequest) (
+	invoice src.Invoice,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_CREATE_INVOICE, request)
+	if mockPtr.CreateInvoiceFunc == nil {
+		errorInfo = notProgrammed(METHOD_CREATE_INVOICE)
+		return
+	}
+
+	return mockPtr.CreateInvoiceFunc(ctx, request)
+}
+
+// CreateInvoiceItem - records the call and returns the reply from CreateInvoiceItemFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by CreateInvoiceItemFunc
+// Verifications: None
+func (mockPtr *PaymentClient) CreateInvoiceItem(ctx context.Context, request src.CreateInvoiceItemRequest) (
+	invoiceItem src.InvoiceItem,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_CREATE_INVOICE_ITEM, request)
+	if mockPtr.CreateInvoiceItemFunc == nil {
+		errorInfo = notProgrammed(METHOD_CREATE_INVOICE_ITEM)
+		return
+	}
+
+	return mockPtr.CreateInvoiceItemFunc(ctx, request)
+}
+
+// CreatePaymentIntent - records the call and returns the reply from CreatePaymentIntentFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by CreatePaymentIntentFunc
+// Verifications: None
+func (mockPtr *PaymentClient) CreatePaymentIntent(ctx context.Context, request src.PaymentIntentRequest) (
+	paymentIntent src.PaymentIntent,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_CREATE_PAYMENT_INTENT, request)
+	if mockPtr.CreatePaymentIntentFunc == nil {
+		errorInfo = notProgrammed(METHOD_CREATE_PAYMENT_INTENT)
+		return
+	}
+
+	return mockPtr.CreatePaymentIntentFunc(ctx, request)
+}
+
+// CreatePaymentLink - records the call and returns the reply from CreatePaymentLinkFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by CreatePaymentLinkFunc
+// Verifications: None
+func (mockPtr *PaymentClient) CreatePaymentLink(ctx context.Context, request src.CreatePaymentLinkRequest) (
+	paymentLink src.PaymentLink,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_CREATE_PAYMENT_LINK, request)
+	if mockPtr.CreatePaymentLinkFunc == nil {
+		errorInfo = notProgrammed(METHOD_CREATE_PAYMENT_LINK)
+		return
+	}
+
+	return mockPtr.CreatePaymentLinkFunc(ctx, request)
+}
+
+// CreatePayout - records the call and returns the reply from CreatePayoutFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by CreatePayoutFunc
+// Verifications: None
+func (mockPtr *PaymentClient) CreatePayout(ctx context.Context, request src.CreatePayoutRequest) (
+	payout src.Payout,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_CREATE_PAYOUT, request)
+	if mockPtr.CreatePayoutFunc == nil {
+		errorInfo = notProgrammed(METHOD_CREATE_PAYOUT)
+		return
+	}
+
+	return mockPtr.CreatePayoutFunc(ctx, request)
+}
+
+// CreatePrice - records the call and returns the reply from CreatePriceFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by CreatePriceFunc
+// Verifications: None
+func (mockPtr *PaymentClient) CreatePrice(ctx context.Context, request src.CreatePriceRequest) (
+	price src.Price,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_CREATE_PRICE, request)
+	if mockPtr.CreatePriceFunc == nil {
+		errorInfo = notProgrammed(METHOD_CREATE_PRICE)
+		return
+	}
+
+	return mockPtr.CreatePriceFunc(ctx, request)
+}
+
+// CreateProduct - records the call and returns the reply from CreateProductFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by CreateProductFunc
+// Verifications: None
+func (mockPtr *PaymentClient) CreateProduct(ctx context.Context, request src.CreateProductRequest) (
+	product src.Product,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_CREATE_PRODUCT, request)
+	if mockPtr.CreateProductFunc == nil {
+		errorInfo = notProgrammed(METHOD_CREATE_PRODUCT)
+		return
+	}
+
+	return mockPtr.CreateProductFunc(ctx, request)
+}
+
+// CreatePromotionCode - records the call and returns the reply from CreatePromotionCodeFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by CreatePromotionCodeFunc
+// Verifications: None
+func (mockPtr *PaymentClient) CreatePromotionCode(ctx context.Context, request src.CreatePromotionCodeRequest) (
+	promotionCode src.PromotionCode,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_CREATE_PROMOTION_CODE, request)
+	if mockPtr.CreatePromotionCodeFunc == nil {
+		errorInfo = notProgrammed(METHOD_CREATE_PROMOTION_CODE)
+		return
+	}
+
+	return mockPtr.CreatePromotionCodeFunc(ctx, request)
+}
+
+// CreateRefund - records the call and returns the reply from CreateRefundFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by CreateRefundFunc
+// Verifications: None
+func (mockPtr *PaymentClient) CreateRefund(ctx context.Context, request src.CreateRefundRequest) (
+	refund src.Refund,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_CREATE_REFUND, request)
+	if mockPtr.CreateRefundFunc == nil {
+		errorInfo = notProgrammed(METHOD_CREATE_REFUND)
+		return
+	}
+
+	return mockPtr.CreateRefundFunc(ctx, request)
+}
+
+// CreateSetupIntent - records the call and returns the reply from CreateSetupIntentFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by CreateSetupIntentFunc
+// Verifications: None
+func (mockPtr *PaymentClient) CreateSetupIntent(ctx context.Context, request src.CreateSetupIntentRequest) (
+	setupIntent src.SetupIntent,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_CREATE_SETUP_INTENT, request)
+	if mockPtr.CreateSetupIntentFunc == nil {
+		errorInfo = notProgrammed(METHOD_CREATE_SETUP_INTENT)
+		return
+	}
+
+	return mockPtr.CreateSetupIntentFunc(ctx, request)
+}
+
+// CreateSubscription - records the call and returns the reply from CreateSubscriptionFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by CreateSubscriptionFunc
+// Verifications: None
+func (mockPtr *PaymentClient) CreateSubscription(ctx context.Context, request src.CreateSubscriptionRequest) (
+	subscription src.Subscription,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_CREATE_SUBSCRIPTION, request)
+	if mockPtr.CreateSubscriptionFunc == nil {
+		errorInfo = notProgrammed(METHOD_CREATE_SUBSCRIPTION)
+		return
+	}
+
+	return mockPtr.CreateSubscriptionFunc(ctx, request)
+}
+
+// DeactivatePaymentLink - records the call and returns the reply from DeactivatePaymentLinkFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by DeactivatePaymentLinkFunc
+// Verifications: None
+func (mockPtr *PaymentClient) DeactivatePaymentLink(ctx context.Context, request src.DeactivatePaymentLinkRequest) (
+	paymentLink src.PaymentLink,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_DEACTIVATE_PAYMENT_LINK, request)
+	if mockPtr.DeactivatePaymentLinkFunc == nil {
+		errorInfo = notProgrammed(METHOD_DEACTIVATE_PAYMENT_LINK)
+		return
+	}
+
+	return mockPtr.DeactivatePaymentLinkFunc(ctx, request)
+}
+
+// DeactivatePromotionCode - records the call and returns the reply from DeactivatePromotionCodeFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by DeactivatePromotionCodeFunc
+// Verifications: None
+func (mockPtr *PaymentClient) DeactivatePromotionCode(ctx context.Context, request src.DeactivatePromotionCodeRequest) (
+	promotionCode src.PromotionCode,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_DEACTIVATE_PROMOTION_CODE, request)
+	if mockPtr.DeactivatePromotionCodeFunc == nil {
+		errorInfo = notProgrammed(METHOD_DEACTIVATE_PROMOTION_CODE)
+		return
+	}
+
+	return mockPtr.DeactivatePromotionCodeFunc(ctx, request)
+}
+
+// DeleteCoupon - records the call and returns the reply from DeleteCouponFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by DeleteCouponFunc
+// Verifications: None
+func (mockPtr *PaymentClient) DeleteCoupon(ctx context.Context, request src.DeleteCouponRequest) (
+	deletedResult src.DeletedResult,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_DELETE_COUPON, request)
+	if mockPtr.DeleteCouponFunc == nil {
+		errorInfo = notProgrammed(METHOD_DELETE_COUPON)
+		return
+	}
+
+	return mockPtr.DeleteCouponFunc(ctx, request)
+}
+
+// DeleteCustomer - records the call and returns the reply from DeleteCustomerFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by DeleteCustomerFunc
+// Verifications: None
+func (mockPtr *PaymentClient) DeleteCustomer(ctx context.Context, request src.DeleteCustomerRequest) (
+	deletedResult src.DeletedResult,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_DELETE_CUSTOMER, request)
+	if mockPtr.DeleteCustomerFunc == nil {
+		errorInfo = notProgrammed(METHOD_DELETE_CUSTOMER)
+		return
+	}
+
+	return mockPtr.DeleteCustomerFunc(ctx, request)
+}
+
+// DeleteInvoiceItem - records the call and returns the reply from DeleteInvoiceItemFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by DeleteInvoiceItemFunc
+// Verifications: None
+func (mockPtr *PaymentClient) DeleteInvoiceItem(ctx context.Context, request src.DeleteInvoiceItemRequest) (
+	deletedResult src.DeletedResult,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_DELETE_INVOICE_ITEM, request)
+	if mockPtr.DeleteInvoiceItemFunc == nil {
+		errorInfo = notProgrammed(METHOD_DELETE_INVOICE_ITEM)
+		return
+	}
+
+	return mockPtr.DeleteInvoiceItemFunc(ctx, request)
+}
+
+// DetachPaymentMethod - records the call and returns the reply from DetachPaymentMethodFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by DetachPaymentMethodFunc
+// Verifications: None
+func (mockPtr *PaymentClient) DetachPaymentMethod(ctx context.Context, request src.DetachPaymentMethodRequest) (
+	paymentMethod src.PaymentMethod,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_DETACH_PAYMENT_METHOD, request)
+	if mockPtr.DetachPaymentMethodFunc == nil {
+		errorInfo = notProgrammed(METHOD_DETACH_PAYMENT_METHOD)
+		return
+	}
+
+	return mockPtr.DetachPaymentMethodFunc(ctx, request)
+}
+
+// ExpireCheckoutSession - records the call and returns the reply from ExpireCheckoutSessionFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by ExpireCheckoutSessionFunc
+// Verifications: None
+func (mockPtr *PaymentClient) ExpireCheckoutSession(ctx context.Context, request src.ExpireCheckoutSessionRequest) (
+	checkoutSession src.CheckoutSession,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_EXPIRE_CHECKOUT_SESSION, request)
+	if mockPtr.ExpireCheckoutSessionFunc == nil {
+		errorInfo = notProgrammed(METHOD_EXPIRE_CHECKOUT_SESSION)
+		return
+	}
+
+	return mockPtr.ExpireCheckoutSessionFunc(ctx, request)
+}
+
+// FinalizeInvoice - records the call and returns the reply from FinalizeInvoiceFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by FinalizeInvoiceFunc
+// Verifications: None
+func (mockPtr *PaymentClient) FinalizeInvoice(ctx context.Context, request src.FinalizeInvoiceRequest) (
+	invoice src.Invoice,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_FINALIZE_INVOICE, request)
+	if mockPtr.FinalizeInvoiceFunc == nil {
+		errorInfo = notProgrammed(METHOD_FINALIZE_INVOICE)
+		return
+	}
+
+	return mockPtr.FinalizeInvoiceFunc(ctx, request)
+}
+
+// GetBalance - records the call and returns the reply from GetBalanceFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by GetBalanceFunc
+// Verifications: None
+func (mockPtr *PaymentClient) GetBalance(ctx context.Context, request src.GetBalanceRequest) (
+	balance src.Balance,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_GET_BALANCE, request)
+	if mockPtr.GetBalanceFunc == nil {
+		errorInfo = notProgrammed(METHOD_GET_BALANCE)
+		return
+	}
+
+	return mockPtr.GetBalanceFunc(ctx, request)
+}
+
+// GetCheckoutSession - records the call and returns the reply from GetCheckoutSessionFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by GetCheckoutSessionFunc
+// Verifications: None
+func (mockPtr *PaymentClient) GetCheckoutSession(ctx context.Context, request src.GetCheckoutSessionRequest) (
+	checkoutSession src.CheckoutSession,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_GET_CHECKOUT_SESSION, request)
+	if mockPtr.GetCheckoutSessionFunc == nil {
+		errorInfo = notProgrammed(METHOD_GET_CHECKOUT_SESSION)
+		return
+	}
+
+	return mockPtr.GetCheckoutSessionFunc(ctx, request)
+}
+
+// GetCoupon - records the call and returns the reply from GetCouponFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by GetCouponFunc
+// Verifications: None
+func (mockPtr *PaymentClient) GetCoupon(ctx context.Context, request src.GetCouponRequest) (
+	coupon src.Coupon,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_GET_COUPON, request)
+	if mockPtr.GetCouponFunc == nil {
+		errorInfo = notProgrammed(METHOD_GET_COUPON)
+		return
+	}
+
+	return mockPtr.GetCouponFunc(ctx, request)
+}
+
+// GetCustomer - records the call and returns the reply from GetCustomerFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by GetCustomerFunc
+// Verifications: None
+func (mockPtr *PaymentClient) GetCustomer(ctx context.Context, request src.GetCustomerRequest) (
+	customer src.Customer,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_GET_CUSTOMER, request)
+	if mockPtr.GetCustomerFunc == nil {
+		errorInfo = notProgrammed(METHOD_GET_CUSTOMER)
+		return
+	}
+
+	return mockPtr.GetCustomerFunc(ctx, request)
+}
+
+// GetDispute - records the call and returns the reply from GetDisputeFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by GetDisputeFunc
+// Verifications: None
+func (mockPtr *PaymentClient) GetDispute(ctx context.Context, request src.GetDisputeRequest) (
+	dispute src.Dispute,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_GET_DISPUTE, request)
+	if mockPtr.GetDisputeFunc == nil {
+		errorInfo = notProgrammed(METHOD_GET_DISPUTE)
+		return
+	}
+
+	return mockPtr.GetDisputeFunc(ctx, request)
+}
+
+// GetInvoice - records the call and returns the reply from GetInvoiceFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by GetInvoiceFunc
+// Verifications: None
+func (mockPtr *PaymentClient) GetInvoice(ctx context.Context, request src.GetInvoiceRequest) (
+	invoice src.Invoice,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_GET_INVOICE, request)
+	if mockPtr.GetInvoiceFunc == nil {
+		errorInfo = notProgrammed(METHOD_GET_INVOICE)
+		return
+	}
+
+	return mockPtr.GetInvoiceFunc(ctx, request)
+}
+
+// GetPaymentIntent - records the call and returns the reply from GetPaymentIntentFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by GetPaymentIntentFunc
+// Verifications: None
+func (mockPtr *PaymentClient) GetPaymentIntent(ctx context.Context, request src.GetPaymentIntentRequest) (
+	paymentIntent src.PaymentIntent,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_GET_PAYMENT_INTENT, request)
+	if mockPtr.GetPaymentIntentFunc == nil {
+		errorInfo = notProgrammed(METHOD_GET_PAYMENT_INTENT)
+		return
+	}
+
+	return mockPtr.GetPaymentIntentFunc(ctx, request)
+}
+
+// GetPayout - records the call and returns the reply from GetPayoutFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by GetPayoutFunc
+// Verifications: None
+func (mockPtr *PaymentClient) GetPayout(ctx context.Context, request src.GetPayoutRequest) (
+	payout src.Payout,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_GET_PAYOUT, request)
+	if mockPtr.GetPayoutFunc == nil {
+		errorInfo = notProgrammed(METHOD_GET_PAYOUT)
+		return
+	}
+
+	return mockPtr.GetPayoutFunc(ctx, request)
+}
+
+// GetPrice - records the call and returns the reply from GetPriceFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by GetPriceFunc
+// Verifications: None
+func (mockPtr *PaymentClient) GetPrice(ctx context.Context, request src.GetPriceRequest) (
+	price src.Price,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_GET_PRICE, request)
+	if mockPtr.GetPriceFunc == nil {
+		errorInfo = notProgrammed(METHOD_GET_PRICE)
+		return
+	}
+
+	return mockPtr.GetPriceFunc(ctx, request)
+}
+
+// GetProduct - records the call and returns the reply from GetProductFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by GetProductFunc
+// Verifications: None
+func (mockPtr *PaymentClient) GetProduct(ctx context.Context, request src.GetProductRequest) (
+	product src.Product,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_GET_PRODUCT, request)
+	if mockPtr.GetProductFunc == nil {
+		errorInfo = notProgrammed(METHOD_GET_PRODUCT)
+		return
+	}
+
+	return mockPtr.GetProductFunc(ctx, request)
+}
+
+// GetPromotionCode - records the call and returns the reply from GetPromotionCodeFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by GetPromotionCodeFunc
+// Verifications: None
+func (mockPtr *PaymentClient) GetPromotionCode(ctx context.Context, request src.GetPromotionCodeRequest) (
+	promotionCode src.PromotionCode,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_GET_PROMOTION_CODE, request)
+	if mockPtr.GetPromotionCodeFunc == nil {
+		errorInfo = notProgrammed(METHOD_GET_PROMOTION_CODE)
+		return
+	}
+
+	return mockPtr.GetPromotionCodeFunc(ctx, request)
+}
+
+// GetRefund - records the call and returns the reply from GetRefundFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by GetRefundFunc
+// Verifications: None
+func (mockPtr *PaymentClient) GetRefund(ctx context.Context, request src.GetRefundRequest) (
+	refund src.Refund,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_GET_REFUND, request)
+	if mockPtr.GetRefundFunc == nil {
+		errorInfo = notProgrammed(METHOD_GET_REFUND)
+		return
+	}
+
+	return mockPtr.GetRefundFunc(ctx, request)
+}
+
+// GetSetupIntent - records the call and returns the reply from GetSetupIntentFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by GetSetupIntentFunc
+// Verifications: None
+func (mockPtr *PaymentClient) GetSetupIntent(ctx context.Context, request src.GetSetupIntentRequest) (
+	setupIntent src.SetupIntent,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_GET_SETUP_INTENT, request)
+	if mockPtr.GetSetupIntentFunc == nil {
+		errorInfo = notProgrammed(METHOD_GET_SETUP_INTENT)
+		return
+	}
+
+	return mockPtr.GetSetupIntentFunc(ctx, request)
+}
+
+// GetSubscription - records the call and returns the reply from GetSubscriptionFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by GetSubscriptionFunc
+// Verifications: None
+func (mockPtr *PaymentClient) GetSubscription(ctx context.Context, request src.GetSubscriptionRequest) (
+	subscription src.Subscription,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_GET_SUBSCRIPTION, request)
+	if mockPtr.GetSubscriptionFunc == nil {
+		errorInfo = notProgrammed(METHOD_GET_SUBSCRIPTION)
+		return
+	}
+
+	return mockPtr.GetSubscriptionFunc(ctx, request)
+}
+
+// ListBalanceTransactions - records the call and returns the reply from ListBalanceTransactionsFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by ListBalanceTransactionsFunc
+// Verifications: None
+func (mockPtr *PaymentClient) ListBalanceTransactions(ctx context.Context, request src.ListBalanceTransactionsRequest) (
+	balanceTransactionList src.BalanceTransactionList,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_LIST_BALANCE_TRANSACTIONS, request)
+	if mockPtr.ListBalanceTransactionsFunc == nil {
+		errorInfo = notProgrammed(METHOD_LIST_BALANCE_TRANSACTIONS)
+		return
+	}
+
+	return mockPtr.ListBalanceTransactionsFunc(ctx, request)
+}
+
+// ListCoupons - records the call and returns the reply from ListCouponsFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by ListCouponsFunc
+// Verifications: None
+func (mockPtr *PaymentClient) ListCoupons(ctx context.Context, request src.ListCouponsRequest) (
+	couponList src.CouponList,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_LIST_COUPONS, request)
+	if mockPtr.ListCouponsFunc == nil {
+		errorInfo = notProgrammed(METHOD_LIST_COUPONS)
+		return
+	}
+
+	return mockPtr.ListCouponsFunc(ctx, request)
+}
+
+// ListCustomerPaymentMethods - records the call and returns the reply from ListCustomerPaymentMethodsFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by ListCustomerPaymentMethodsFunc
+// Verifications: None
+func (mockPtr *PaymentClient) ListCustomerPaymentMethods(ctx context.Context, request src.ListCustomerPaymentMethodsRequest) (
+	paymentMethodList src.PaymentMethodList,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_LIST_CUSTOMER_PAYMENT_METHODS, request)
+	if mockPtr.ListCustomerPaymentMethodsFunc == nil {
+		errorInfo = notProgrammed(METHOD_LIST_CUSTOMER_PAYMENT_METHODS)
+		return
+	}
+
+	return mockPtr.ListCustomerPaymentMethodsFunc(ctx, request)
+}
+
+// ListCustomers - records the call and returns the reply from ListCustomersFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by ListCustomersFunc
+// Verifications: None
+func (mockPtr *PaymentClient) ListCustomers(ctx context.Context, request src.ListCustomersRequest) (
+	customerList src.CustomerList,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_LIST_CUSTOMERS, request)
+	if mockPtr.ListCustomersFunc == nil {
+		errorInfo = notProgrammed(METHOD_LIST_CUSTOMERS)
+		return
+	}
+
+	return mockPtr.ListCustomersFunc(ctx, request)
+}
+
+// ListDisputes - records the call and returns the reply from ListDisputesFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by ListDisputesFunc
+// Verifications: None
+func (mockPtr *PaymentClient) ListDisputes(ctx context.Context, request src.ListDisputesRequest) (
+	disputeList src.DisputeList,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_LIST_DISPUTES, request)
+	if mockPtr.ListDisputesFunc == nil {
+		errorInfo = notProgrammed(METHOD_LIST_DISPUTES)
+		return
+	}
+
+	return mockPtr.ListDisputesFunc(ctx, request)
+}
+
+// ListInvoiceItems - records the call and returns the reply from ListInvoiceItemsFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by ListInvoiceItemsFunc
+// Verifications: None
+func (mockPtr *PaymentClient) ListInvoiceItems(ctx context.Context, request src.ListInvoiceItemsRequest) (
+	invoiceItemList src.InvoiceItemList,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_LIST_INVOICE_ITEMS, request)
+	if mockPtr.ListInvoiceItemsFunc == nil {
+		errorInfo = notProgrammed(METHOD_LIST_INVOICE_ITEMS)
+		return
+	}
+
+	return mockPtr.ListInvoiceItemsFunc(ctx, request)
+}
+
+// ListInvoices - records the call and returns the reply from ListInvoicesFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by ListInvoicesFunc
+// Verifications: None
+func (mockPtr *PaymentClient) ListInvoices(ctx context.Context, request src.ListInvoicesRequest) (
+	invoiceList src.InvoiceList,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_LIST_INVOICES, request)
+	if mockPtr.ListInvoicesFunc == nil {
+		errorInfo = notProgrammed(METHOD_LIST_INVOICES)
+		return
+	}
+
+	return mockPtr.ListInvoicesFunc(ctx, request)
+}
+
+// ListPaymentIntents - records the call and returns the reply from ListPaymentIntentsFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by ListPaymentIntentsFunc
+// Verifications: None
+func (mockPtr *PaymentClient) ListPaymentIntents(ctx context.Context, request src.ListPaymentIntentRequest) (
+	paymentIntentList src.PaymentIntentList,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_LIST_PAYMENT_INTENTS, request)
+	if mockPtr.ListPaymentIntentsFunc == nil {
+		errorInfo = notProgrammed(METHOD_LIST_PAYMENT_INTENTS)
+		return
+	}
+
+	return mockPtr.ListPaymentIntentsFunc(ctx, request)
+}
+
+// ListPaymentLinks - records the call and returns the reply from ListPaymentLinksFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by ListPaymentLinksFunc
+// Verifications: None
+func (mockPtr *PaymentClient) ListPaymentLinks(ctx context.Context, request src.ListPaymentLinksRequest) (
+	paymentLinkList src.PaymentLinkList,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_LIST_PAYMENT_LINKS, request)
+	if mockPtr.ListPaymentLinksFunc == nil {
+		errorInfo = notProgrammed(METHOD_LIST_PAYMENT_LINKS)
+		return
+	}
+
+	return mockPtr.ListPaymentLinksFunc(ctx, request)
+}
+
+// ListPaymentMethods - records the call and returns the reply from ListPaymentMethodsFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by ListPaymentMethodsFunc
+// Verifications: None
+func (mockPtr *PaymentClient) ListPaymentMethods(ctx context.Context, request src.ListPaymentMethodRequest) (
+	paymentMethodList src.PaymentMethodList,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_LIST_PAYMENT_METHODS, request)
+	if mockPtr.ListPaymentMethodsFunc == nil {
+		errorInfo = notProgrammed(METHOD_LIST_PAYMENT_METHODS)
+		return
+	}
+
+	return mockPtr.ListPaymentMethodsFunc(ctx, request)
+}
+
+// ListPayouts - records the call and returns the reply from ListPayoutsFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by ListPayoutsFunc
+// Verifications: None
+func (mockPtr *PaymentClient) ListPayouts(ctx context.Context, request src.ListPayoutsRequest) (
+	payoutList src.PayoutList,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_LIST_PAYOUTS, request)
+	if mockPtr.ListPayoutsFunc == nil {
+		errorInfo = notProgrammed(METHOD_LIST_PAYOUTS)
+		return
+	}
+
+	return mockPtr.ListPayoutsFunc(ctx, request)
+}
+
+// ListPrices - records the call and returns the reply from ListPricesFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by ListPricesFunc
+// Verifications: None
+func (mockPtr *PaymentClient) ListPrices(ctx context.Context, request src.ListPricesRequest) (
+	priceList src.PriceList,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_LIST_PRICES, request)
+	if mockPtr.ListPricesFunc == nil {
+		errorInfo = notProgrammed(METHOD_LIST_PRICES)
+		return
+	}
+
+	return mockPtr.ListPricesFunc(ctx, request)
+}
+
+// ListProducts - records the call and returns the reply from ListProductsFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by ListProductsFunc
+// Verifications: None
+func (mockPtr *PaymentClient) ListProducts(ctx context.Context, request src.ListProductsRequest) (
+	productList src.ProductList,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_LIST_PRODUCTS, request)
+	if mockPtr.ListProductsFunc == nil {
+		errorInfo = notProgrammed(METHOD_LIST_PRODUCTS)
+		return
+	}
+
+	return mockPtr.ListProductsFunc(ctx, request)
+}
+
+// ListPromotionCodes - records the call and returns the reply from ListPromotionCodesFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by ListPromotionCodesFunc
+// Verifications: None
+func (mockPtr *PaymentClient) ListPromotionCodes(ctx context.Context, request src.ListPromotionCodesRequest) (
+	promotionCodeList src.PromotionCodeList,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_LIST_PROMOTION_CODES, request)
+	if mockPtr.ListPromotionCodesFunc == nil {
+		errorInfo = notProgrammed(METHOD_LIST_PROMOTION_CODES)
+		return
+	}
+
+	return mockPtr.ListPromotionCodesFunc(ctx, request)
+}
+
+// ListRefunds - records the call and returns the reply from ListRefundsFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by ListRefundsFunc
+// Verifications: None
+func (mockPtr *PaymentClient) ListRefunds(ctx context.Context, request src.ListRefundsRequest) (
+	refundList src.RefundList,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_LIST_REFUNDS, request)
+	if mockPtr.ListRefundsFunc == nil {
+		errorInfo = notProgrammed(METHOD_LIST_REFUNDS)
+		return
+	}
+
+	return mockPtr.ListRefundsFunc(ctx, request)
+}
+
+// ListSetupIntents - records the call and returns the reply from ListSetupIntentsFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by ListSetupIntentsFunc
+// Verifications: None
+func (mockPtr *PaymentClient) ListSetupIntents(ctx context.Context, request src.ListSetupIntentsRequest) (
+	setupIntentList src.SetupIntentList,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_LIST_SETUP_INTENTS, request)
+	if mockPtr.ListSetupIntentsFunc == nil {
+		errorInfo = notProgrammed(METHOD_LIST_SETUP_INTENTS)
+		return
+	}
+
+	return mockPtr.ListSetupIntentsFunc(ctx, request)
+}
+
+// ListSubscriptions - records the call and returns the reply from ListSubscriptionsFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by ListSubscriptionsFunc
+// Verifications: None
+func (mockPtr *PaymentClient) ListSubscriptions(ctx context.Context, request src.ListSubscriptionsRequest) (
+	subscriptionList src.SubscriptionList,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_LIST_SUBSCRIPTIONS, request)
+	if mockPtr.ListSubscriptionsFunc == nil {
+		errorInfo = notProgrammed(METHOD_LIST_SUBSCRIPTIONS)
+		return
+	}
+
+	return mockPtr.ListSubscriptionsFunc(ctx, request)
+}
+
+// MarkInvoiceUncollectible - records the call and returns the reply from MarkInvoiceUncollectibleFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by MarkInvoiceUncollectibleFunc
+// Verifications: None
+func (mockPtr *PaymentClient) MarkInvoiceUncollectible(ctx context.Context, request src.MarkInvoiceUncollectibleRequest) (
+	invoice src.Invoice,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_MARK_INVOICE_UNCOLLECTIBLE, request)
+	if mockPtr.MarkInvoiceUncollectibleFunc == nil {
+		errorInfo = notProgrammed(METHOD_MARK_INVOICE_UNCOLLECTIBLE)
+		return
+	}
+
+	return mockPtr.MarkInvoiceUncollectibleFunc(ctx, request)
+}
+
+// PayInvoice - records the call and returns the reply from PayInvoiceFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by PayInvoiceFunc
+// Verifications: None
+func (mockPtr *PaymentClient) PayInvoice(ctx context.Context, request src.PayInvoiceRequest) (
+	invoice src.Invoice,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_PAY_INVOICE, request)
+	if mockPtr.PayInvoiceFunc == nil {
+		errorInfo = notProgrammed(METHOD_PAY_INVOICE)
+		return
+	}
+
+	return mockPtr.PayInvoiceFunc(ctx, request)
+}
+
+// Reset - removes the recorded calls. The Func fields are kept.
+//
+// Customer Messages: None
+// Errors: None
+// Verifications: None
+func (mockPtr *PaymentClient) Reset() {
+
+	mockPtr.lock.Lock()
+	defer mockPtr.lock.Unlock()
+
+	mockPtr.calls = nil
+}
+
+// SendInvoice - records the call and returns the reply from SendInvoiceFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by SendInvoiceFunc
+// Verifications: None
+func (mockPtr *PaymentClient) SendInvoice(ctx context.Context, request src.SendInvoiceRequest) (
+	invoice src.Invoice,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_SEND_INVOICE, request)
+	if mockPtr.SendInvoiceFunc == nil {
+		errorInfo = notProgrammed(METHOD_SEND_INVOICE)
+		return
+	}
+
+	return mockPtr.SendInvoiceFunc(ctx, request)
+}
+
+// SetDefaultPaymentMethod - records the call and returns the reply from SetDefaultPaymentMethodFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by SetDefaultPaymentMethodFunc
+// Verifications: None
+func (mockPtr *PaymentClient) SetDefaultPaymentMethod(ctx context.Context, request src.SetDefaultPaymentMethodRequest) (
+	customer src.Customer,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_SET_DEFAULT_PAYMENT_METHOD, request)
+	if mockPtr.SetDefaultPaymentMethodFunc == nil {
+		errorInfo = notProgrammed(METHOD_SET_DEFAULT_PAYMENT_METHOD)
+		return
+	}
+
+	return mockPtr.SetDefaultPaymentMethodFunc(ctx, request)
+}
+
+// UpdateCoupon - records the call and returns the reply from UpdateCouponFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by UpdateCouponFunc
+// Verifications: None
+func (mockPtr *PaymentClient) UpdateCoupon(ctx context.Context, request src.UpdateCouponRequest) (
+	coupon src.Coupon,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_UPDATE_COUPON, request)
+	if mockPtr.UpdateCouponFunc == nil {
+		errorInfo = notProgrammed(METHOD_UPDATE_COUPON)
+		return
+	}
+
+	return mockPtr.UpdateCouponFunc(ctx, request)
+}
+
+// UpdateCustomer - records the call and returns the reply from UpdateCustomerFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by UpdateCustomerFunc
+// Verifications: None
+func (mockPtr *PaymentClient) UpdateCustomer(ctx context.Context, request src.UpdateCustomerRequest) (
+	customer src.Customer,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_UPDATE_CUSTOMER, request)
+	if mockPtr.UpdateCustomerFunc == nil {
+		errorInfo = notProgrammed(METHOD_UPDATE_CUSTOMER)
+		return
+	}
+
+	return mockPtr.UpdateCustomerFunc(ctx, request)
+}
+
+// UpdateDispute - records the call and returns the reply from UpdateDisputeFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by UpdateDisputeFunc
+// Verifications: None
+func (mockPtr *PaymentClient) UpdateDispute(ctx context.Context, request src.UpdateDisputeRequest) (
+	dispute src.Dispute,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_UPDATE_DISPUTE, request)
+	if mockPtr.UpdateDisputeFunc == nil {
+		errorInfo = notProgrammed(METHOD_UPDATE_DISPUTE)
+		return
+	}
+
+	return mockPtr.UpdateDisputeFunc(ctx, request)
+}
+
+// UpdatePaymentIntent - records the call and returns the reply from UpdatePaymentIntentFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by UpdatePaymentIntentFunc
+// Verifications: None
+func (mockPtr *PaymentClient) UpdatePaymentIntent(ctx context.Context, request src.UpdatePaymentIntentRequest) (
+	paymentIntent src.PaymentIntent,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_UPDATE_PAYMENT_INTENT, request)
+	if mockPtr.UpdatePaymentIntentFunc == nil {
+		errorInfo = notProgrammed(METHOD_UPDATE_PAYMENT_INTENT)
+		return
+	}
+
+	return mockPtr.UpdatePaymentIntentFunc(ctx, request)
+}
+
+// UpdatePaymentLink - records the call and returns the reply from UpdatePaymentLinkFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by UpdatePaymentLinkFunc
+// Verifications: None
+func (mockPtr *PaymentClient) UpdatePaymentLink(ctx context.Context, request src.UpdatePaymentLinkRequest) (
+	paymentLink src.PaymentLink,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_UPDATE_PAYMENT_LINK, request)
+	if mockPtr.UpdatePaymentLinkFunc == nil {
+		errorInfo = notProgrammed(METHOD_UPDATE_PAYMENT_LINK)
+		return
+	}
+
+	return mockPtr.UpdatePaymentLinkFunc(ctx, request)
+}
+
+// UpdatePrice - records the call and returns the reply from UpdatePriceFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by UpdatePriceFunc
+// Verifications: None
+func (mockPtr *PaymentClient) UpdatePrice(ctx context.Context, request src.UpdatePriceRequest) (
+	price src.Price,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_UPDATE_PRICE, request)
+	if mockPtr.UpdatePriceFunc == nil {
+		errorInfo = notProgrammed(METHOD_UPDATE_PRICE)
+		return
+	}
+
+	return mockPtr.UpdatePriceFunc(ctx, request)
+}
+
+// UpdateProduct - records the call and returns the reply from UpdateProductFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by UpdateProductFunc
+// Verifications: None
+func (mockPtr *PaymentClient) UpdateProduct(ctx context.Context, request src.UpdateProductRequest) (
+	product src.Product,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_UPDATE_PRODUCT, request)
+	if mockPtr.UpdateProductFunc == nil {
+		errorInfo = notProgrammed(METHOD_UPDATE_PRODUCT)
+		return
+	}
+
+	return mockPtr.UpdateProductFunc(ctx, request)
+}
+
+// UpdatePromotionCode - records the call and returns the reply from UpdatePromotionCodeFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by UpdatePromotionCodeFunc
+// Verifications: None
+func (mockPtr *PaymentClient) UpdatePromotionCode(ctx context.Context, request src.UpdatePromotionCodeRequest) (
+	promotionCode src.PromotionCode,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_UPDATE_PROMOTION_CODE, request)
+	if mockPtr.UpdatePromotionCodeFunc == nil {
+		errorInfo = notProgrammed(METHOD_UPDATE_PROMOTION_CODE)
+		return
+	}
+
+	return mockPtr.UpdatePromotionCodeFunc(ctx, request)
+}
+
+// UpdateSubscription - records the call and returns the reply from UpdateSubscriptionFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by UpdateSubscriptionFunc
+// Verifications: None
+func (mockPtr *PaymentClient) UpdateSubscription(ctx context.Context, request src.UpdateSubscriptionRequest) (
+	subscription src.Subscription,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_UPDATE_SUBSCRIPTION, request)
+	if mockPtr.UpdateSubscriptionFunc == nil {
+		errorInfo = notProgrammed(METHOD_UPDATE_SUBSCRIPTION)
+		return
+	}
+
+	return mockPtr.UpdateSubscriptionFunc(ctx, request)
+}
+
+// VoidInvoice - records the call and returns the reply from VoidInvoiceFunc.
+//
+// Customer Messages: None
+// Errors: ErrNotProgrammed, Errors returned by VoidInvoiceFunc
+// Verifications: None
+func (mockPtr *PaymentClient) VoidInvoice(ctx context.Context, request src.VoidInvoiceRequest) (
+	invoice src.Invoice,
+	errorInfo pi.ErrorInfo,
+) {
+
+	mockPtr.record(METHOD_VOID_INVOICE, request)
+	if mockPtr.VoidInvoiceFunc == nil {
+		errorInfo = notProgrammed(METHOD_VOID_INVOICE)
+		return
+	}
+
+	return mockPtr.VoidInvoiceFunc(ctx, request)
+}
+
+// Private Function below here
+
+// notProgrammed - returns ErrNotProgrammed for the method.
+//
+//	Customer Messages: None
+//	Errors: ErrNotProgrammed
+//	Verifications: None
+func notProgrammed(method string) (errorInfo pi.ErrorInfo) {
+
+	return pi.NewErrorInfo(ErrNotProgrammed, fmt.Sprintf("%v%v", TXT_METHOD, method))
+}
+
+// record - adds the call to the recorded calls.
+//
+//	Customer Messages: None
+//	Errors: None
+//	Verifications: None
+func (mockPtr *PaymentClient) record(method string, request interface{}) {
+
+	mockPtr.lock.Lock()
+	defer mockPtr.lock.Unlock()
+
+	mockPtr.calls = append(mockPtr.calls, Call{Method: method, Request: request})
+}
@@ -0,0 +1,84 @@
+package ai2cmock
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"ai2c-go-client/src"
+	pi "github.com/sty-holdings/sty-shared/v2024/programInfo"
+)
+
+func TestProgrammedFunc(t *testing.T) {
+
+	var (
+		tGotRequest src.CancelPaymentIntentRequest
+		tMock       = &PaymentClient{
+			CancelPaymentIntentFunc: func(ctx context.Context, request src.CancelPaymentIntentRequest) (src.CancelResult, pi.ErrorInfo) {
+				tGotRequest = request
+				return src.CancelResult{PaymentIntent: src.PaymentIntent{Id: request.PaymentIntentId, Status: src.PAYMENT_INTENT_STATUS_CANCELED}}, pi.ErrorInfo{}
+			},
+		}
+		tRequest = src.CancelPaymentIntentRequest{SaaSKey: "sk_test", PaymentIntentId: "pi_1", CancellationReason: "abandoned"}
+	)
+
+	tCancelResult, errorInfo := tMock.CancelPaymentIntent(context.Background(), tRequest)
+	if errorInfo.Error != nil {
+		t.Fatalf("CancelPaymentIntent error = %v", errorInfo.Error)
+	}
+	if tCancelResult.Id != "pi_1" || tCancelResult.Canceled() == false {
+		t.Errorf("CancelPaymentIntent = %+v, want the reply from CancelPaymentIntentFunc", tCancelResult)
+	}
+	if tGotRequest != tRequest {
+		t.Errorf("CancelPaymentIntentFunc request = %+v, want %+v", tGotRequest, tRequest)
+	}
+}
+
+func TestCallsAndReset(t *testing.T) {
+
+	var (
+		tMock          = &PaymentClient{}
+		tCancelRequest = src.CancelPaymentIntentRequest{SaaSKey: "sk_test", PaymentIntentId: "pi_1", CancellationReason: "abandoned"}
+		tListRequest   = src.ListPaymentMethodRequest{SaaSKey: "sk_test"}
+	)
+
+	tMock.CancelPaymentIntent(context.Background(), tCancelRequest)
+	tMock.ListPaymentMethods(context.Background(), tListRequest)
+	tMock.CancelPaymentIntent(context.Background(), tCancelRequest)
+
+	tCalls := tMock.Calls()
+	if len(tCalls) != 3 {
+		t.Fatalf("Calls() = %+v, want 3 calls", tCalls)
+	}
+	if tCalls[0].Method != METHOD_CANCEL_PAYMENT_INTENT || tCalls[0].Request != tCancelRequest {
+		t.Errorf("Calls()[0] = %+v, want %v with %+v", tCalls[0], METHOD_CANCEL_PAYMENT_INTENT, tCancelRequest)
+	}
+	if tCalls[1].Method != METHOD_LIST_PAYMENT_METHODS || tCalls[1].Request != tListRequest {
+		t.Errorf("Calls()[1] = %+v, want %v with %+v", tCalls[1], METHOD_LIST_PAYMENT_METHODS, tListRequest)
+	}
+	if count := tMock.CallCount(METHOD_CANCEL_PAYMENT_INTENT); count != 2 {
+		t.Errorf("CallCount(%v) = %v, want 2", METHOD_CANCEL_PAYMENT_INTENT, count)
+	}
+
+	tMock.Reset()
+	if tCalls = tMock.Calls(); len(tCalls) != 0 {
+		t.Errorf("Calls() after Reset = %+v, want none", tCalls)
+	}
+	if count := tMock.CallCount(METHOD_CANCEL_PAYMENT_INTENT); count != 0 {
+		t.Errorf("CallCount(%v) after Reset = %v, want 0", METHOD_CANCEL_PAYMENT_INTENT, count)
+	}
+}
+
+func TestNotProgrammed(t *testing.T) {
+
+	var (
+		tMock = &PaymentClient{}
+	)
+
+	if _, errorInfo := tMock.CancelPaymentIntent(context.Background(), src.CancelPaymentIntentRequest{}); errors.Is(errorInfo.Error, ErrNotProgrammed) == false {
+		t.Errorf("CancelPaymentIntent error = %v, want %v", errorInfo.Error, ErrNotProgrammed)
+	}
+	if count := tMock.CallCount(METHOD_CANCEL_PAYMENT_INTENT); count != 1 {
+		t.Errorf("CallCount(%v) = %v, want the unprogrammed call recorded", METHOD_CANCEL_PAYMENT_INTENT, count)
+	}
+}
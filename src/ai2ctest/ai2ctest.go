@@ -0,0 +1,514 @@
+// Package ai2ctest
+/*
+This is an in-process fake of the AI2C NATS service for testing code that uses an Ai2CClient without AWS Cognito,
+AWS SSM, or the AI2C NATS service.
+
+RESTRICTIONS:
+	The fake server does not use TLS or NATS credentials. It is only meant for tests.
+
+NOTES:
+    NewServer starts an embedded NATS server on 127.0.0.1 using a random port and subscribes to the AI2C
+    subjects. Requests are decrypted with the fake's client id and secret key, the same way the AI2C service does,
+    and are recorded so tests can inspect them with Requests.
+
+    Replies are scripted per subject:
+        Reply queues a canned reply. ReplyError queues an error reply. Queued replies are returned in order and the
+        last one is repeated.
+        Handle sets a function that builds the reply from the request. It takes precedence over queued replies.
+    A request on a subject without a script gets an error reply with the code REPLY_CODE_NOT_SCRIPTED.
+
+    NewClient returns an Ai2CClient connected to the fake server. Closing the client does not stop the server.
+
+COPYRIGHT:
+	Copyright 2022
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+
+*/
+package ai2ctest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"ai2c-go-client/src"
+	"github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go"
+	ctv "github.com/sty-holdings/constant-type-vars-go/v2024"
+	jwts "github.com/sty-holdings/sty-shared/v2024/jwtServices"
+	pi "github.com/sty-holdings/sty-shared/v2024/programInfo"
+)
+
+//goland:noinspection ALL
+const (
+	DEFAULT_CLIENT_ID       = "ai2ctest-client-id"
+	DEFAULT_ENVIRONMENT     = "development"
+	DEFAULT_PASSWORD        = "ai2ctest-password"
+	DEFAULT_SECRET_KEY      = "ai2ctest-secret-key-0123456789ab"
+	DEFAULT_USERNAME        = "ai2ctest"
+	SERVER_READY_TIMEOUT    = 5 * time.Second
+	REPLY_CODE_DECRYPT      = "decrypt_failed"
+	REPLY_CODE_NOT_SCRIPTED = "not_scripted"
+)
+
+//goland:noinspection ALL
+const (
+	TXT_FAKE_SERVER = "AI2C fake server: "
+)
+
+var (
+	ErrServerNotReady = errors.New("the embedded NATS server did not start in time")
+)
+
+// subjects - the AI2C subjects the fake server subscribes to when it starts.
+var subjects = []string{
+	ctv.SUB_STRIPE_CANCEL_PAYMENT_INTENT,
+	src.SUB_STRIPE_CAPTURE_PAYMENT_INTENT,
+	src.SUB_STRIPE_CONFIRM_PAYMENT_INTENT,
+	ctv.SUB_STRIPE_CREATE_PAYMENT_INTENT,
+	src.SUB_STRIPE_GET_PAYMENT_INTENT,
+	ctv.SUB_STRIPE_LIST_PAYMENT_INTENTS,
+	ctv.SUB_STRIPE_LIST_PAYMENT_METHODS,
+	src.SUB_STRIPE_UPDATE_PAYMENT_INTENT,
+	src.SUB_STRIPE_CANCEL_REFUND,
+	src.SUB_STRIPE_CREATE_REFUND,
+	src.SUB_STRIPE_GET_REFUND,
+	src.SUB_STRIPE_LIST_REFUNDS,
+	src.SUB_STRIPE_CREATE_CUSTOMER,
+	src.SUB_STRIPE_DELETE_CUSTOMER,
+	src.SUB_STRIPE_GET_CUSTOMER,
+	src.SUB_STRIPE_LIST_CUSTOMERS,
+	src.SUB_STRIPE_UPDATE_CUSTOMER,
+	src.SUB_STRIPE_ATTACH_PAYMENT_METHOD,
+	src.SUB_STRIPE_DETACH_PAYMENT_METHOD,
+	src.SUB_STRIPE_LIST_CUSTOMER_PAYMENT_METHODS,
+	src.SUB_STRIPE_CANCEL_SETUP_INTENT,
+	src.SUB_STRIPE_CONFIRM_SETUP_INTENT,
+	src.SUB_STRIPE_CREATE_SETUP_INTENT,
+	src.SUB_STRIPE_GET_SETUP_INTENT,
+	src.SUB_STRIPE_LIST_SETUP_INTENTS,
+	src.SUB_STRIPE_CANCEL_SUBSCRIPTION,
+	src.SUB_STRIPE_CREATE_SUBSCRIPTION,
+	src.SUB_STRIPE_GET_SUBSCRIPTION,
+	src.SUB_STRIPE_LIST_SUBSCRIPTIONS,
+	src.SUB_STRIPE_UPDATE_SUBSCRIPTION,
+	src.SUB_STRIPE_CREATE_PRICE,
+	src.SUB_STRIPE_CREATE_PRODUCT,
+	src.SUB_STRIPE_GET_PRICE,
+	src.SUB_STRIPE_GET_PRODUCT,
+	src.SUB_STRIPE_LIST_PRICES,
+	src.SUB_STRIPE_LIST_PRODUCTS,
+	src.SUB_STRIPE_UPDATE_PRICE,
+	src.SUB_STRIPE_UPDATE_PRODUCT,
+	src.SUB_STRIPE_CREATE_INVOICE,
+	src.SUB_STRIPE_CREATE_INVOICE_ITEM,
+	src.SUB_STRIPE_DELETE_INVOICE_ITEM,
+	src.SUB_STRIPE_FINALIZE_INVOICE,
+	src.SUB_STRIPE_GET_INVOICE,
+	src.SUB_STRIPE_LIST_INVOICE_ITEMS,
+	src.SUB_STRIPE_LIST_INVOICES,
+	src.SUB_STRIPE_MARK_INVOICE_UNCOLLECTIBLE,
+	src.SUB_STRIPE_PAY_INVOICE,
+	src.SUB_STRIPE_SEND_INVOICE,
+	src.SUB_STRIPE_VOID_INVOICE,
+	src.SUB_STRIPE_CREATE_CHECKOUT_SESSION,
+	src.SUB_STRIPE_CREATE_PAYMENT_LINK,
+	src.SUB_STRIPE_EXPIRE_CHECKOUT_SESSION,
+	src.SUB_STRIPE_GET_CHECKOUT_SESSION,
+	src.SUB_STRIPE_LIST_PAYMENT_LINKS,
+	src.SUB_STRIPE_UPDATE_PAYMENT_LINK,
+	src.SUB_STRIPE_CLOSE_DISPUTE,
+	src.SUB_STRIPE_GET_DISPUTE,
+	src.SUB_STRIPE_LIST_DISPUTES,
+	src.SUB_STRIPE_UPDATE_DISPUTE,
+	src.SUB_STRIPE_CREATE_PAYOUT,
+	src.SUB_STRIPE_GET_BALANCE,
+	src.SUB_STRIPE_GET_PAYOUT,
+	src.SUB_STRIPE_LIST_BALANCE_TRANSACTIONS,
+	src.SUB_STRIPE_LIST_PAYOUTS,
+	src.SUB_STRIPE_CREATE_COUPON,
+	src.SUB_STRIPE_DELETE_COUPON,
+	src.SUB_STRIPE_GET_COUPON,
+	src.SUB_STRIPE_LIST_COUPONS,
+	src.SUB_STRIPE_UPDATE_COUPON,
+	src.SUB_STRIPE_CREATE_PROMOTION_CODE,
+	src.SUB_STRIPE_GET_PROMOTION_CODE,
+	src.SUB_STRIPE_LIST_PROMOTION_CODES,
+	src.SUB_STRIPE_UPDATE_PROMOTION_CODE,
+}
+
+// HandlerFunc - builds the reply for a request. When replyError is not nil, it is sent as an error reply and reply
+// is ignored. Otherwise, reply is encoded as JSON unless it is a []byte or json.RawMessage, which is sent as is.
+type HandlerFunc func(request Request) (reply interface{}, replyError *src.ReplyError)
+
+// Request - a decrypted request received by the fake server.
+type Request struct {
+	Subject  string
+	ClientId string
+	Username string
+	Data     json.RawMessage
+}
+
+// Server - an embedded NATS server answering AI2C requests with scripted replies.
+type Server struct {
+	clientId      string
+	connPtr       *nats.Conn
+	handlers      map[string]HandlerFunc
+	lock          sync.Mutex
+	replies       map[string][][]byte
+	requests      []Request
+	secretKey     string
+	serverPtr     *server.Server
+	subscriptions map[string]*nats.Subscription
+}
+
+// NewServer - starts the fake server using DEFAULT_CLIENT_ID and DEFAULT_SECRET_KEY to decrypt requests.
+//
+// Customer Messages: None
+// Errors: ErrServerNotReady, nats errors
+// Verifications: None
+func NewServer() (serverPtr *Server, errorInfo pi.ErrorInfo) {
+
+	return NewServerWithCredentials(DEFAULT_CLIENT_ID, DEFAULT_SECRET_KEY)
+}
+
+// NewServerWithCredentials - starts the fake server using clientId and secretKey to decrypt requests. Clients must
+// be built with the same client id and secret key.
+//
+// Customer Messages: None
+// Errors: ErrServerNotReady, nats errors
+// Verifications: None
+func NewServerWithCredentials(clientId, secretKey string) (serverPtr *Server, errorInfo pi.ErrorInfo) {
+
+	var (
+		tServerPtr = &Server{
+			clientId:      clientId,
+			handlers:      make(map[string]HandlerFunc),
+			replies:       make(map[string][][]byte),
+			secretKey:     secretKey,
+			subscriptions: make(map[string]*nats.Subscription),
+		}
+	)
+
+	if tServerPtr.serverPtr, errorInfo.Error = server.NewServer(&server.Options{
+		Host:   "127.0.0.1",
+		Port:   server.RANDOM_PORT,
+		NoLog:  true,
+		NoSigs: true,
+	}); errorInfo.Error != nil {
+		errorInfo = pi.NewErrorInfo(errorInfo.Error, TXT_FAKE_SERVER)
+		return
+	}
+	go tServerPtr.serverPtr.Start()
+	if tServerPtr.serverPtr.ReadyForConnections(SERVER_READY_TIMEOUT) == false {
+		tServerPtr.serverPtr.Shutdown()
+		errorInfo = pi.NewErrorInfo(ErrServerNotReady, TXT_FAKE_SERVER)
+		return
+	}
+
+	if tServerPtr.connPtr, errorInfo.Error = nats.Connect(tServerPtr.URL()); errorInfo.Error != nil {
+		tServerPtr.serverPtr.Shutdown()
+		errorInfo = pi.NewErrorInfo(errorInfo.Error, fmt.Sprintf("%v%v", TXT_FAKE_SERVER, tServerPtr.URL()))
+		return
+	}
+
+	for _, subject := range subjects {
+		if errorInfo = tServerPtr.subscribe(subject); errorInfo.Error != nil {
+			tServerPtr.Close()
+			return
+		}
+	}
+
+	return tServerPtr, errorInfo
+}
+
+// Close - stops the fake server. Clients connected to it lose their connection.
+//
+// Customer Messages: None
+// Errors: None
+// Verifications: None
+func (serverPtr *Server) Close() {
+
+	if serverPtr.connPtr != nil {
+		serverPtr.connPtr.Close()
+	}
+	serverPtr.serverPtr.Shutdown()
+	serverPtr.serverPtr.WaitForShutdown()
+}
+
+// Handle - sets the function that builds the replies for the subject.
+//
+// Customer Messages: None
+// Errors: nats errors
+// Verifications: None
+func (serverPtr *Server) Handle(subject string, handler HandlerFunc) (errorInfo pi.ErrorInfo) {
+
+	serverPtr.lock.Lock()
+	serverPtr.handlers[subject] = handler
+	serverPtr.lock.Unlock()
+
+	return serverPtr.subscribe(subject)
+}
+
+// NewClient - builds an Ai2CClient connected to the fake server with the server's client id and secret key, and
+// DEFAULT_ENVIRONMENT. The options are applied after the fake server's options.
+//
+// Customer Messages: None
+// Errors: nats errors, Errors returned by src.New
+// Verifications: None
+func (serverPtr *Server) NewClient(opts ...src.Option) (clientPtr *src.Ai2CClient, errorInfo pi.ErrorInfo) {
+
+	var (
+		tConnPtr *nats.Conn
+	)
+
+	if tConnPtr, errorInfo.Error = nats.Connect(serverPtr.URL()); errorInfo.Error != nil {
+		errorInfo = pi.NewErrorInfo(errorInfo.Error, fmt.Sprintf("%v%v", TXT_FAKE_SERVER, serverPtr.URL()))
+		return
+	}
+
+	if clientPtr, errorInfo = src.New(append([]src.Option{
+		src.WithCredentials(serverPtr.clientId, DEFAULT_USERNAME, DEFAULT_PASSWORD, serverPtr.secretKey),
+		src.WithEnvironment(DEFAULT_ENVIRONMENT),
+		src.WithNATSConnection(tConnPtr),
+	}, opts...)...); errorInfo.Error != nil {
+		tConnPtr.Close()
+	}
+
+	return
+}
+
+// Reply - queues a canned reply for the subject. The reply is encoded as JSON unless it is a []byte or
+// json.RawMessage, which is sent as is.
+//
+// Customer Messages: None
+// Errors: json errors, nats errors
+// Verifications: None
+func (serverPtr *Server) Reply(subject string, reply interface{}) (errorInfo pi.ErrorInfo) {
+
+	var (
+		tReply []byte
+	)
+
+	if tReply, errorInfo = encodeReply(reply); errorInfo.Error != nil {
+		return
+	}
+
+	serverPtr.lock.Lock()
+	serverPtr.replies[subject] = append(serverPtr.replies[subject], tReply)
+	serverPtr.lock.Unlock()
+
+	return serverPtr.subscribe(subject)
+}
+
+// ReplyError - queues an error reply for the subject. The client returns it as a *src.ReplyError wrapped in
+// src.ErrReplyError.
+//
+// Customer Messages: None
+// Errors: json errors, nats errors
+// Verifications: None
+func (serverPtr *Server) ReplyError(subject string, replyError src.ReplyError) (errorInfo pi.ErrorInfo) {
+
+	return serverPtr.Reply(subject, errorReply(replyError))
+}
+
+// Requests - returns a copy of the requests received so far in the order they arrived.
+//
+// Customer Messages: None
+// Errors: None
+// Verifications: None
+func (serverPtr *Server) Requests() (requests []Request) {
+
+	serverPtr.lock.Lock()
+	defer serverPtr.lock.Unlock()
+
+	return append([]Request(nil), serverPtr.requests...)
+}
+
+// Reset - removes the scripted replies, handlers, and recorded requests. The subscriptions are kept.
+//
+// Customer Messages: None
+// Errors: None
+// Verifications: None
+func (serverPtr *Server) Reset() {
+
+	serverPtr.lock.Lock()
+	defer serverPtr.lock.Unlock()
+
+	serverPtr.handlers = make(map[string]HandlerFunc)
+	serverPtr.replies = make(map[string][][]byte)
+	serverPtr.requests = nil
+}
+
+// URL - returns the URL clients use to connect to the fake server.
+//
+// Customer Messages: None
+// Errors: None
+// Verifications: None
+func (serverPtr *Server) URL() (url string) {
+
+	return serverPtr.serverPtr.ClientURL()
+}
+
+// Private Function below here
+
+// encodeReply - returns the reply as JSON. A []byte or json.RawMessage is returned as is.
+//
+//	Customer Messages: None
+//	Errors: json errors
+//	Verifications: None
+func encodeReply(reply interface{}) (encodedReply []byte, errorInfo pi.ErrorInfo) {
+
+	switch tReply := reply.(type) {
+	case []byte:
+		return tReply, errorInfo
+	case json.RawMessage:
+		return tReply, errorInfo
+	}
+
+	if encodedReply, errorInfo.Error = json.Marshal(reply); errorInfo.Error != nil {
+		errorInfo = pi.NewErrorInfo(errorInfo.Error, TXT_FAKE_SERVER)
+	}
+
+	return
+}
+
+// errorReply - wraps replyError in the error object returned by the AI2C service.
+//
+//	Customer Messages: None
+//	Errors: None
+//	Verifications: None
+func errorReply(replyError src.ReplyError) (reply interface{}) {
+
+	return struct {
+		Error src.ReplyError `json:"error"`
+	}{
+		Error: replyError,
+	}
+}
+
+// handleMessage - decrypts and records the request, then sends the scripted reply.
+//
+//	Customer Messages: None
+//	Errors: None
+//	Verifications: None
+func (serverPtr *Server) handleMessage(msg *nats.Msg) {
+
+	var (
+		tData      []byte
+		tErrorInfo pi.ErrorInfo
+		tReply     []byte
+		tRequest   = Request{
+			Subject: msg.Subject,
+		}
+	)
+
+	if msg.Header != nil {
+		tRequest.ClientId = msg.Header.Get(ctv.FN_STYH_CLIENT_ID)
+		tRequest.Username = msg.Header.Get(ctv.FN_USERNAME)
+	}
+
+	if tData, tErrorInfo = jwts.Decrypt(serverPtr.clientId, serverPtr.secretKey, string(msg.Data)); tErrorInfo.Error != nil {
+		tReply, _ = encodeReply(errorReply(src.ReplyError{
+			Code:    REPLY_CODE_DECRYPT,
+			Message: tErrorInfo.Error.Error(),
+		}))
+		_ = msg.Respond(tReply)
+		return
+	}
+	tRequest.Data = tData
+
+	serverPtr.lock.Lock()
+	serverPtr.requests = append(serverPtr.requests, tRequest)
+	tHandler := serverPtr.handlers[msg.Subject]
+	tReplies := serverPtr.replies[msg.Subject]
+	if tHandler == nil && len(tReplies) > 0 {
+		tReply = tReplies[0]
+		// The last reply is repeated.
+		if len(tReplies) > 1 {
+			serverPtr.replies[msg.Subject] = tReplies[1:]
+		}
+	}
+	serverPtr.lock.Unlock()
+
+	switch {
+	case tHandler != nil:
+		tReply = handleRequest(tHandler, tRequest)
+	case tReply == nil:
+		tReply, _ = encodeReply(errorReply(src.ReplyError{
+			Code:    REPLY_CODE_NOT_SCRIPTED,
+			Message: fmt.Sprintf("no reply is scripted for %v", msg.Subject),
+		}))
+	}
+
+	_ = msg.Respond(tReply)
+}
+
+// handleRequest - calls the handler and encodes its reply. An encoding failure is sent as an error reply.
+//
+//	Customer Messages: None
+//	Errors: None
+//	Verifications: None
+func handleRequest(handler HandlerFunc, request Request) (reply []byte) {
+
+	var (
+		tErrorInfo  pi.ErrorInfo
+		tReply      interface{}
+		tReplyError *src.ReplyError
+	)
+
+	if tReply, tReplyError = handler(request); tReplyError != nil {
+		tReply = errorReply(*tReplyError)
+	}
+	if reply, tErrorInfo = encodeReply(tReply); tErrorInfo.Error != nil {
+		reply, _ = encodeReply(errorReply(src.ReplyError{
+			Message: tErrorInfo.Error.Error(),
+		}))
+	}
+
+	return
+}
+
+// subscribe - subscribes to the subject unless the server is already subscribed. The subscription is flushed, so
+// requests sent after subscribe returns are answered.
+//
+//	Customer Messages: None
+//	Errors: nats errors
+//	Verifications: None
+func (serverPtr *Server) subscribe(subject string) (errorInfo pi.ErrorInfo) {
+
+	var (
+		tSubscriptionPtr *nats.Subscription
+	)
+
+	serverPtr.lock.Lock()
+	defer serverPtr.lock.Unlock()
+
+	if _, ok := serverPtr.subscriptions[subject]; ok {
+		return
+	}
+
+	if tSubscriptionPtr, errorInfo.Error = serverPtr.connPtr.Subscribe(subject, serverPtr.handleMessage); errorInfo.Error != nil {
+		errorInfo = pi.NewErrorInfo(errorInfo.Error, fmt.Sprintf("%v%v%v", TXT_FAKE_SERVER, ctv.TXT_SUBJECT, subject))
+		return
+	}
+	if errorInfo.Error = serverPtr.connPtr.Flush(); errorInfo.Error != nil {
+		errorInfo = pi.NewErrorInfo(errorInfo.Error, fmt.Sprintf("%v%v%v", TXT_FAKE_SERVER, ctv.TXT_SUBJECT, subject))
+		return
+	}
+	serverPtr.subscriptions[subject] = tSubscriptionPtr
+
+	return
+}
@@ -0,0 +1,171 @@
+package ai2ctest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"ai2c-go-client/src"
+	ctv "github.com/sty-holdings/constant-type-vars-go/v2024"
+)
+
+func TestReplyRecordsRequest(t *testing.T) {
+
+	var (
+		tSent src.CancelPaymentIntentRequest
+	)
+
+	tServerPtr, tClientPtr := newTestClient(t)
+	if errorInfo := tServerPtr.Reply(ctv.SUB_STRIPE_CANCEL_PAYMENT_INTENT, src.PaymentIntent{Id: "pi_1", Status: src.PAYMENT_INTENT_STATUS_CANCELED}); errorInfo.Error != nil {
+		t.Fatalf("Reply error = %v", errorInfo.Error)
+	}
+
+	tCancelResult, errorInfo := tClientPtr.CancelPaymentIntent(context.Background(), testCancelRequest())
+	if errorInfo.Error != nil {
+		t.Fatalf("CancelPaymentIntent error = %v", errorInfo.Error)
+	}
+	if tCancelResult.Id != "pi_1" || tCancelResult.Canceled() == false {
+		t.Errorf("CancelPaymentIntent = %+v, want the scripted reply", tCancelResult)
+	}
+
+	tRequests := tServerPtr.Requests()
+	if len(tRequests) != 1 {
+		t.Fatalf("requests = %+v, want one", tRequests)
+	}
+	if tRequests[0].Subject != ctv.SUB_STRIPE_CANCEL_PAYMENT_INTENT || tRequests[0].ClientId != DEFAULT_CLIENT_ID ||
+		tRequests[0].Username != DEFAULT_USERNAME {
+		t.Errorf("request = %+v", tRequests[0])
+	}
+	if err := json.Unmarshal(tRequests[0].Data, &tSent); err != nil {
+		t.Fatalf("the request was not decrypted: json.Unmarshal(%s) error = %v", tRequests[0].Data, err)
+	}
+	if tSent != testCancelRequest() {
+		t.Errorf("decrypted request = %+v, want %+v", tSent, testCancelRequest())
+	}
+
+	tServerPtr.Reset()
+	if tRequests = tServerPtr.Requests(); len(tRequests) != 0 {
+		t.Errorf("requests after Reset = %+v, want none", tRequests)
+	}
+}
+
+func TestReplyErrorSurfacesInClient(t *testing.T) {
+
+	var (
+		tReplyErrorPtr *src.ReplyError
+		tReplyError    = src.ReplyError{Code: "card_declined", DeclineCode: "generic_decline", Message: "Your card was declined."}
+	)
+
+	tServerPtr, tClientPtr := newTestClient(t)
+	if errorInfo := tServerPtr.ReplyError(ctv.SUB_STRIPE_CANCEL_PAYMENT_INTENT, tReplyError); errorInfo.Error != nil {
+		t.Fatalf("ReplyError error = %v", errorInfo.Error)
+	}
+
+	_, errorInfo := tClientPtr.CancelPaymentIntent(context.Background(), testCancelRequest())
+	if errors.Is(errorInfo.Error, src.ErrReplyError) == false || errors.As(errorInfo.Error, &tReplyErrorPtr) == false {
+		t.Fatalf("CancelPaymentIntent error = %v, want a *src.ReplyError wrapped in %v", errorInfo.Error, src.ErrReplyError)
+	}
+	if *tReplyErrorPtr != tReplyError {
+		t.Errorf("reply error = %+v, want %+v", *tReplyErrorPtr, tReplyError)
+	}
+}
+
+func TestNotScripted(t *testing.T) {
+
+	var (
+		tReplyErrorPtr *src.ReplyError
+	)
+
+	tServerPtr, tClientPtr := newTestClient(t)
+
+	_, errorInfo := tClientPtr.CancelPaymentIntent(context.Background(), testCancelRequest())
+	if errors.As(errorInfo.Error, &tReplyErrorPtr) == false || tReplyErrorPtr.Code != REPLY_CODE_NOT_SCRIPTED {
+		t.Fatalf("CancelPaymentIntent error = %v, want the code %v", errorInfo.Error, REPLY_CODE_NOT_SCRIPTED)
+	}
+	if tRequests := tServerPtr.Requests(); len(tRequests) != 1 {
+		t.Errorf("requests = %+v, want the request recorded", tRequests)
+	}
+}
+
+// TestSubjectsCoverSrc - every SUB_STRIPE_ constant declared in src must be subscribed to when the server starts.
+func TestSubjectsCoverSrc(t *testing.T) {
+
+	var (
+		tFileSet    = token.NewFileSet()
+		tFound      int
+		tSubscribed = make(map[string]bool)
+	)
+
+	for _, subject := range subjects {
+		tSubscribed[subject] = true
+	}
+
+	tPackages, err := parser.ParseDir(tFileSet, "..", func(info os.FileInfo) bool {
+		return strings.HasSuffix(info.Name(), "_test.go") == false
+	}, 0)
+	if err != nil {
+		t.Fatalf("parsing src error = %v", err)
+	}
+
+	for _, tPackage := range tPackages {
+		for _, tFile := range tPackage.Files {
+			for _, tDecl := range tFile.Decls {
+				tGenDecl, ok := tDecl.(*ast.GenDecl)
+				if ok == false || tGenDecl.Tok != token.CONST {
+					continue
+				}
+				for _, tSpec := range tGenDecl.Specs {
+					tValueSpec := tSpec.(*ast.ValueSpec)
+					for i, tName := range tValueSpec.Names {
+						if strings.HasPrefix(tName.Name, "SUB_STRIPE_") == false || i >= len(tValueSpec.Values) {
+							continue
+						}
+						tLiteral, ok := tValueSpec.Values[i].(*ast.BasicLit)
+						if ok == false || tLiteral.Kind != token.STRING {
+							continue
+						}
+						tSubject, _ := strconv.Unquote(tLiteral.Value)
+						tFound++
+						if tSubscribed[tSubject] == false {
+							t.Errorf("src.%v (%q) is not in subjects", tName.Name, tSubject)
+						}
+					}
+				}
+			}
+		}
+	}
+
+	if tFound == 0 {
+		t.Fatal("no SUB_STRIPE_ constants were found in src")
+	}
+}
+
+// newTestClient - starts a fake server and returns a client connected to it. Both are closed by the test cleanup.
+func newTestClient(t *testing.T) (serverPtr *Server, clientPtr *src.Ai2CClient) {
+
+	serverPtr, tErrorInfo := NewServer()
+	if tErrorInfo.Error != nil {
+		t.Fatalf("NewServer error = %v", tErrorInfo.Error)
+	}
+	t.Cleanup(serverPtr.Close)
+
+	if clientPtr, tErrorInfo = serverPtr.NewClient(); tErrorInfo.Error != nil {
+		t.Fatalf("NewClient error = %v", tErrorInfo.Error)
+	}
+	t.Cleanup(func() { clientPtr.Close() })
+
+	return serverPtr, clientPtr
+}
+
+// testCancelRequest - returns the request the tests send to the fake server.
+func testCancelRequest() (request src.CancelPaymentIntentRequest) {
+
+	return src.CancelPaymentIntentRequest{SaaSKey: "sk_test", PaymentIntentId: "pi_1", CancellationReason: "abandoned"}
+}
@@ -0,0 +1,215 @@
+// Package src
+/*
+These are the balance and balance transaction operations of the Ai2CClient.
+
+RESTRICTIONS:
+	None
+
+NOTES:
+    The balance is the money held by Stripe for the account, by currency. Pending funds become available on the
+    AvailableOn date of their balance transaction and are then paid out.
+
+    Every movement of the balance, such as a charge, refund, fee, or payout, is a balance transaction. Listing the
+    balance transactions of a payout, with PayoutId, gives the payments settled by that payout for reconciliation.
+
+COPYRIGHT:
+	Copyright 2022
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+
+*/
+package src
+
+import (
+	"context"
+	"fmt"
+
+	ctv "github.com/sty-holdings/constant-type-vars-go/v2024"
+	pi "github.com/sty-holdings/sty-shared/v2024/programInfo"
+)
+
+//goland:noinspection ALL
+const (
+	BALANCE_TRANSACTION_STATUS_AVAILABLE = "available"
+	BALANCE_TRANSACTION_STATUS_PENDING   = "pending"
+)
+
+//goland:noinspection ALL
+const (
+	BALANCE_TRANSACTION_TYPE_ADJUSTMENT = "adjustment"
+	BALANCE_TRANSACTION_TYPE_CHARGE     = "charge"
+	BALANCE_TRANSACTION_TYPE_PAYMENT    = "payment"
+	BALANCE_TRANSACTION_TYPE_PAYOUT     = "payout"
+	BALANCE_TRANSACTION_TYPE_REFUND     = "refund"
+	BALANCE_TRANSACTION_TYPE_STRIPE_FEE = "stripe_fee"
+)
+
+//goland:noinspection ALL
+const (
+	SUB_STRIPE_GET_BALANCE               = "stripe.balance.get"
+	SUB_STRIPE_LIST_BALANCE_TRANSACTIONS = "stripe.balance-transaction.list"
+)
+
+type Balance struct {
+	Object    string          `json:"object,omitempty"`
+	Available []BalanceAmount `json:"available"`
+	LiveMode  bool            `json:"livemode,omitempty"`
+	Pending   []BalanceAmount `json:"pending"`
+	RawReply
+}
+
+// BalanceAmount - Amount is in minor units. SourceTypes breaks the amount down by source, such as card or
+// bank_account.
+type BalanceAmount struct {
+	Amount      int64            `json:"amount"`
+	Currency    string           `json:"currency"`
+	SourceTypes map[string]int64 `json:"source_types,omitempty"`
+}
+
+// BalanceTransaction - Amount is the gross amount, Fee is what Stripe charged, and Net is Amount less Fee. They are in
+// minor units. SourceId is the id of the object that caused the transaction, such as a charge, refund, or payout.
+type BalanceTransaction struct {
+	Id                string                  `json:"id"`
+	Object            string                  `json:"object,omitempty"`
+	Amount            int64                   `json:"amount"`
+	AvailableOn       int64                   `json:"available_on,omitempty"`
+	Created           int64                   `json:"created,omitempty"`
+	Currency          string                  `json:"currency"`
+	Description       string                  `json:"description,omitempty"`
+	ExchangeRate      float64                 `json:"exchange_rate,omitempty"`
+	Fee               int64                   `json:"fee"`
+	FeeDetails        []BalanceTransactionFee `json:"fee_details,omitempty"`
+	Net               int64                   `json:"net"`
+	ReportingCategory string                  `json:"reporting_category,omitempty"`
+	SourceId          string                  `json:"source,omitempty"`
+	Status            string                  `json:"status"`
+	Type              string                  `json:"type"`
+	RawReply
+}
+
+type BalanceTransactionFee struct {
+	Amount      int64  `json:"amount"`
+	Currency    string `json:"currency"`
+	Description string `json:"description,omitempty"`
+	Type        string `json:"type,omitempty"`
+}
+
+type BalanceTransactionList = List[BalanceTransaction]
+
+type GetBalanceRequest struct {
+	SaaSKey string `json:"saas_key"`
+}
+
+// ListBalanceTransactionsRequest - Created, Currency, PayoutId, SourceId, and Type only return the balance
+// transactions that match them. Type is one of the BALANCE_TRANSACTION_TYPE values or another Stripe type.
+type ListBalanceTransactionsRequest struct {
+	SaaSKey       string     `json:"saas_key"`
+	Created       *DateRange `json:"created,omitempty"`
+	Currency      string     `json:"currency,omitempty"`
+	PayoutId      string     `json:"payout,omitempty"`
+	SourceId      string     `json:"source,omitempty"`
+	Type          string     `json:"type,omitempty"`
+	Limit         int64      `json:"limit,omitempty"`
+	StartingAfter string     `json:"starting_after,omitempty"`
+}
+
+// GetBalance - returns the available and pending balance of the account. The SaaSKey is required.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) GetBalance(ctx context.Context, request GetBalanceRequest) (
+	balance Balance,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_GET_BALANCE, request, &balance)
+
+	return
+}
+
+// ListBalanceTransactions - lists balance transactions, newest first. The SaaSKey is required and the Limit must be
+// set to a value between 1 and 100. StartingAfter is the id of the balance transaction the list starts after. Use
+// BalanceTransactionList.Cursor to get the next page.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing, ErrLimitOutOfRange, ErrDateRangeInvalid, ErrCurrencyInvalid
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) ListBalanceTransactions(ctx context.Context, request ListBalanceTransactionsRequest) (
+	balanceTransactionList BalanceTransactionList,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if errorInfo = validateListLimit(request.Limit); errorInfo.Error != nil {
+		return
+	}
+	if errorInfo = validateDateRange(request.Created); errorInfo.Error != nil {
+		return
+	}
+	if request.Currency != ctv.VAL_EMPTY && isCurrencyValid(request.Currency) == false {
+		errorInfo = pi.NewErrorInfo(ErrCurrencyInvalid, fmt.Sprintf("%v%v", TXT_CURRENCY, request.Currency))
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_LIST_BALANCE_TRANSACTIONS, request, &balanceTransactionList)
+
+	return
+}
+
+// AmountMoney - returns Amount, which is in minor units, with the currency as Money.
+//
+// Customer Messages: None
+// Errors: None
+// Verifications: None
+func (balanceAmountPtr *BalanceAmount) AmountMoney() (amount Money) {
+
+	return replyMoney(balanceAmountPtr.Amount, balanceAmountPtr.Currency)
+}
+
+// AmountMoney - returns Amount, which is in minor units, with the currency as Money.
+//
+// Customer Messages: None
+// Errors: None
+// Verifications: None
+func (balanceTransactionPtr *BalanceTransaction) AmountMoney() (amount Money) {
+
+	return replyMoney(balanceTransactionPtr.Amount, balanceTransactionPtr.Currency)
+}
+
+// FeeMoney - returns Fee, which is in minor units, with the currency as Money.
+//
+// Customer Messages: None
+// Errors: None
+// Verifications: None
+func (balanceTransactionPtr *BalanceTransaction) FeeMoney() (fee Money) {
+
+	return replyMoney(balanceTransactionPtr.Fee, balanceTransactionPtr.Currency)
+}
+
+// NetMoney - returns Net, which is in minor units, with the currency as Money.
+//
+// Customer Messages: None
+// Errors: None
+// Verifications: None
+func (balanceTransactionPtr *BalanceTransaction) NetMoney() (net Money) {
+
+	return replyMoney(balanceTransactionPtr.Net, balanceTransactionPtr.Currency)
+}
@@ -0,0 +1,414 @@
+// Package src
+/*
+These are the checkout session operations of the Ai2CClient.
+
+RESTRICTIONS:
+	None
+
+NOTES:
+    A checkout session is a Stripe hosted payment page. The customer is sent to the session URL and, after paying,
+    is returned to SuccessURL, or to CancelURL when they go back. With the embedded UI mode, the page is shown inside
+    the SaaS application using the ClientSecret and the customer is returned to ReturnURL, the same as the
+    ReturnURL of a PaymentIntentRequest.
+
+    The mode is payment for a one-off payment, subscription to start a subscription, and setup to save a payment
+    method for later, like a setup intent.
+
+COPYRIGHT:
+	Copyright 2022
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+
+*/
+package src
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+
+	ctv "github.com/sty-holdings/constant-type-vars-go/v2024"
+	pi "github.com/sty-holdings/sty-shared/v2024/programInfo"
+)
+
+//goland:noinspection ALL
+const (
+	CHECKOUT_MODE_PAYMENT      = "payment"
+	CHECKOUT_MODE_SETUP        = "setup"
+	CHECKOUT_MODE_SUBSCRIPTION = "subscription"
+)
+
+//goland:noinspection ALL
+const (
+	CHECKOUT_SESSION_STATUS_COMPLETE = "complete"
+	CHECKOUT_SESSION_STATUS_EXPIRED  = "expired"
+	CHECKOUT_SESSION_STATUS_OPEN     = "open"
+)
+
+//goland:noinspection ALL
+const (
+	CHECKOUT_UI_MODE_EMBEDDED = "embedded"
+	CHECKOUT_UI_MODE_HOSTED   = "hosted"
+)
+
+//goland:noinspection ALL
+const (
+	FN_CHECKOUT_SESSION_ID = "checkout_session_id"
+	FN_LINE_ITEMS          = "line_items"
+	FN_MODE                = "mode"
+	FN_RECURRING           = "recurring"
+	FN_RETURN_URL          = "return_url"
+	FN_SUCCESS_URL         = "success_url"
+)
+
+//goland:noinspection ALL
+const (
+	SUB_STRIPE_CREATE_CHECKOUT_SESSION = "stripe.checkout-session.create"
+	SUB_STRIPE_EXPIRE_CHECKOUT_SESSION = "stripe.checkout-session.expire"
+	SUB_STRIPE_GET_CHECKOUT_SESSION    = "stripe.checkout-session.get"
+)
+
+//goland:noinspection ALL
+const (
+	TXT_MODE    = "Mode: "
+	TXT_UI_MODE = "UI mode: "
+	TXT_URL     = "URL: "
+)
+
+var (
+	ErrCheckoutModeInvalid = errors.New("the mode must be payment, setup, or subscription")
+	ErrUIModeInvalid       = errors.New("the UI mode must be embedded or hosted")
+	ErrURLInvalid          = errors.New("the URL must be an absolute http or https URL")
+)
+
+type CheckoutSession struct {
+	Id                string            `json:"id"`
+	Object            string            `json:"object,omitempty"`
+	AmountSubtotal    int64             `json:"amount_subtotal,omitempty"`
+	AmountTotal       int64             `json:"amount_total,omitempty"`
+	CancelURL         string            `json:"cancel_url,omitempty"`
+	ClientReferenceId string            `json:"client_reference_id,omitempty"`
+	ClientSecret      string            `json:"client_secret,omitempty"`
+	Created           int64             `json:"created,omitempty"`
+	Currency          string            `json:"currency,omitempty"`
+	CustomerEmail     string            `json:"customer_email,omitempty"`
+	CustomerId        string            `json:"customer,omitempty"`
+	ExpiresAt         int64             `json:"expires_at,omitempty"`
+	InvoiceId         string            `json:"invoice,omitempty"`
+	LiveMode          bool              `json:"livemode,omitempty"`
+	Metadata          map[string]string `json:"metadata,omitempty"`
+	Mode              string            `json:"mode"`
+	PaymentIntentId   string            `json:"payment_intent,omitempty"`
+	PaymentLinkId     string            `json:"payment_link,omitempty"`
+	PaymentStatus     string            `json:"payment_status,omitempty"`
+	ReturnURL         string            `json:"return_url,omitempty"`
+	SetupIntentId     string            `json:"setup_intent,omitempty"`
+	Status            string            `json:"status"`
+	SubscriptionId    string            `json:"subscription,omitempty"`
+	SuccessURL        string            `json:"success_url,omitempty"`
+	UIMode            string            `json:"ui_mode,omitempty"`
+	URL               string            `json:"url,omitempty"`
+	RawReply
+}
+
+// CheckoutLineItemRequest - the line is either PriceId or an Amount with a product Name, which creates the price
+// for this session only. Recurring is required with an Amount in subscription mode. Quantity defaults to 1.
+type CheckoutLineItemRequest struct {
+	Amount    *Money
+	Name      string
+	PriceId   string
+	Quantity  int64
+	Recurring *PriceRecurring
+}
+
+// CreateCheckoutSessionRequest - the hosted UI mode, the default, requires SuccessURL and the embedded UI mode
+// requires ReturnURL. ClientReferenceId is a SaaS application id, such as an order id, used to reconcile the
+// session. ExpiresAt is a Unix timestamp between 30 minutes and 24 hours from now. Discounts applies coupons or
+// promotion codes to the session. AllowPromotionCodes lets the customer enter a promotion code on the payment page
+// instead, so only one of them can be set.
+type CreateCheckoutSessionRequest struct {
+	SaaSKey             string                    `json:"saas_key"`
+	Mode                string                    `json:"mode"`
+	LineItems           []CheckoutLineItemRequest `json:"line_items,omitempty"`
+	AllowPromotionCodes bool                      `json:"allow_promotion_codes,omitempty"`
+	CancelURL           string                    `json:"cancel_url,omitempty"`
+	ClientReferenceId   string                    `json:"client_reference_id,omitempty"`
+	Currency            string                    `json:"currency,omitempty"`
+	CustomerEmail       string                    `json:"customer_email,omitempty"`
+	CustomerId          string                    `json:"customer,omitempty"`
+	Discounts           []DiscountRequest         `json:"discounts,omitempty"`
+	ExpiresAt           int64                     `json:"expires_at,omitempty"`
+	Metadata            map[string]string         `json:"metadata,omitempty"`
+	PaymentMethodTypes  []string                  `json:"payment_method_types,omitempty"`
+	ReturnURL           string                    `json:"return_url,omitempty"`
+	SuccessURL          string                    `json:"success_url,omitempty"`
+	UIMode              string                    `json:"ui_mode,omitempty"`
+}
+
+// ExpireCheckoutSessionRequest - only an open session can be expired. The customer can no longer pay with it.
+type ExpireCheckoutSessionRequest struct {
+	SaaSKey           string `json:"saas_key"`
+	CheckoutSessionId string `json:"id"`
+}
+
+type GetCheckoutSessionRequest struct {
+	SaaSKey           string `json:"saas_key"`
+	CheckoutSessionId string `json:"id"`
+}
+
+// CreateCheckoutSession - creates a checkout session and returns it with the URL to send the customer to. The
+// SaaSKey and Mode are required. The payment and subscription modes require LineItems. The URLs must be absolute
+// http or https URLs.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing, ErrCheckoutModeInvalid, ErrUIModeInvalid, ErrURLInvalid, ErrAmountAndPriceSet,
+// ErrAmountNotPositive, ErrCurrencyInvalid, ErrIntervalInvalid, ErrQuantityInvalid, ErrDiscountInvalid,
+// ErrDiscountsWithPromotionCodes
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) CreateCheckoutSession(ctx context.Context, request CreateCheckoutSessionRequest) (
+	checkoutSession CheckoutSession,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	switch request.Mode {
+	case ctv.VAL_EMPTY:
+		errorInfo = missingParameter(FN_MODE)
+		return
+	case CHECKOUT_MODE_PAYMENT, CHECKOUT_MODE_SUBSCRIPTION:
+		if len(request.LineItems) == ctv.VAL_ZERO {
+			errorInfo = missingParameter(FN_LINE_ITEMS)
+			return
+		}
+	case CHECKOUT_MODE_SETUP:
+	default:
+		errorInfo = pi.NewErrorInfo(ErrCheckoutModeInvalid, fmt.Sprintf("%v%v", TXT_MODE, request.Mode))
+		return
+	}
+	for _, lineItem := range request.LineItems {
+		if errorInfo = validateCheckoutLineItem(lineItem, request.Mode); errorInfo.Error != nil {
+			return
+		}
+	}
+	switch request.UIMode {
+	case ctv.VAL_EMPTY, CHECKOUT_UI_MODE_HOSTED:
+		if request.SuccessURL == ctv.VAL_EMPTY {
+			errorInfo = missingParameter(FN_SUCCESS_URL)
+			return
+		}
+	case CHECKOUT_UI_MODE_EMBEDDED:
+		if request.ReturnURL == ctv.VAL_EMPTY {
+			errorInfo = missingParameter(FN_RETURN_URL)
+			return
+		}
+	default:
+		errorInfo = pi.NewErrorInfo(ErrUIModeInvalid, fmt.Sprintf("%v%v", TXT_UI_MODE, request.UIMode))
+		return
+	}
+	for _, checkoutURL := range []string{request.CancelURL, request.ReturnURL, request.SuccessURL} {
+		if errorInfo = validateURL(checkoutURL); errorInfo.Error != nil {
+			return
+		}
+	}
+	if request.Currency != ctv.VAL_EMPTY && isCurrencyValid(request.Currency) == false {
+		errorInfo = pi.NewErrorInfo(ErrCurrencyInvalid, fmt.Sprintf("%v%v", TXT_CURRENCY, request.Currency))
+		return
+	}
+	if request.AllowPromotionCodes && len(request.Discounts) > ctv.VAL_ZERO {
+		errorInfo = pi.NewErrorInfo(ErrDiscountsWithPromotionCodes, fmt.Sprintf("%v%+v", TXT_DISCOUNT, request.Discounts))
+		return
+	}
+	if errorInfo = validateDiscounts(request.Discounts); errorInfo.Error != nil {
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_CREATE_CHECKOUT_SESSION, request, &checkoutSession)
+
+	return
+}
+
+// ExpireCheckoutSession - expires the open checkout session identified by CheckoutSessionId and returns the updated
+// session. The SaaSKey and CheckoutSessionId are required.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) ExpireCheckoutSession(ctx context.Context, request ExpireCheckoutSessionRequest) (
+	checkoutSession CheckoutSession,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if request.CheckoutSessionId == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_CHECKOUT_SESSION_ID)
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_EXPIRE_CHECKOUT_SESSION, request, &checkoutSession)
+
+	return
+}
+
+// GetCheckoutSession - returns the checkout session identified by CheckoutSessionId. The SaaSKey and
+// CheckoutSessionId are required.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) GetCheckoutSession(ctx context.Context, request GetCheckoutSessionRequest) (
+	checkoutSession CheckoutSession,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if request.CheckoutSessionId == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_CHECKOUT_SESSION_ID)
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_GET_CHECKOUT_SESSION, request, &checkoutSession)
+
+	return
+}
+
+// MarshalJSON - encodes the line item. An Amount is sent as price data with the amount as a decimal in the major
+// unit, the currency, and the product Name.
+//
+// Customer Messages: None
+// Errors: json errors
+// Verifications: None
+func (lineItem CheckoutLineItemRequest) MarshalJSON() ([]byte, error) {
+
+	type tProductData struct {
+		Name string `json:"name"`
+	}
+
+	type tPriceData struct {
+		Currency    string          `json:"currency"`
+		ProductData tProductData    `json:"product_data"`
+		Recurring   *PriceRecurring `json:"recurring,omitempty"`
+		UnitAmount  json.Number     `json:"unit_amount"`
+	}
+
+	var (
+		tPriceDataPtr *tPriceData
+		tQuantity     = lineItem.Quantity
+	)
+
+	if tQuantity == 0 {
+		tQuantity = 1
+	}
+	if lineItem.Amount != nil {
+		tPriceDataPtr = &tPriceData{
+			ProductData: tProductData{Name: lineItem.Name},
+			Recurring:   lineItem.Recurring,
+		}
+		tPriceDataPtr.UnitAmount, tPriceDataPtr.Currency = decimalAmount(lineItem.Amount)
+	}
+
+	return json.Marshal(struct {
+		PriceId   string      `json:"price,omitempty"`
+		PriceData *tPriceData `json:"price_data,omitempty"`
+		Quantity  int64       `json:"quantity"`
+	}{
+		PriceId:   lineItem.PriceId,
+		PriceData: tPriceDataPtr,
+		Quantity:  tQuantity,
+	})
+}
+
+// AmountTotalMoney - returns AmountTotal, which is in minor units, with the currency as Money.
+//
+// Customer Messages: None
+// Errors: None
+// Verifications: None
+func (checkoutSessionPtr *CheckoutSession) AmountTotalMoney() (amountTotal Money) {
+
+	return replyMoney(checkoutSessionPtr.AmountTotal, checkoutSessionPtr.Currency)
+}
+
+// Private Function below here
+
+// validateCheckoutLineItem - returns an error unless the line item has either a PriceId or a valid Amount and Name,
+// and, for an Amount in subscription mode, a Recurring interval.
+//
+//	Customer Messages: None
+//	Errors: ErrRequiredArgumentMissing, ErrAmountAndPriceSet, ErrAmountNotPositive, ErrCurrencyInvalid,
+//	ErrIntervalInvalid, ErrQuantityInvalid
+//	Verifications: None
+func validateCheckoutLineItem(lineItem CheckoutLineItemRequest, mode string) (errorInfo pi.ErrorInfo) {
+
+	if lineItem.Quantity < 0 {
+		errorInfo = pi.NewErrorInfo(ErrQuantityInvalid, fmt.Sprintf("%v%v", TXT_QUANTITY, lineItem.Quantity))
+		return
+	}
+	if lineItem.Amount == nil {
+		if lineItem.PriceId == ctv.VAL_EMPTY {
+			errorInfo = missingParameter(FN_PRICE_ID)
+		}
+		return
+	}
+	if lineItem.PriceId != ctv.VAL_EMPTY {
+		errorInfo = pi.NewErrorInfo(ErrAmountAndPriceSet, fmt.Sprintf("%v%v", TXT_AMOUNT, *lineItem.Amount))
+		return
+	}
+	if errorInfo = validateAmount(*lineItem.Amount); errorInfo.Error != nil {
+		return
+	}
+	if lineItem.Name == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_NAME)
+		return
+	}
+	if mode == CHECKOUT_MODE_SUBSCRIPTION && lineItem.Recurring == nil {
+		errorInfo = missingParameter(FN_RECURRING)
+		return
+	}
+	if lineItem.Recurring != nil {
+		errorInfo = validatePriceRecurring(*lineItem.Recurring)
+	}
+
+	return
+}
+
+// validateURL - returns an error unless the URL is empty or an absolute http or https URL.
+//
+//	Customer Messages: None
+//	Errors: ErrURLInvalid
+//	Verifications: None
+func validateURL(rawURL string) (errorInfo pi.ErrorInfo) {
+
+	var (
+		tURLPtr *url.URL
+		err     error
+	)
+
+	if rawURL == ctv.VAL_EMPTY {
+		return
+	}
+
+	if tURLPtr, err = url.Parse(rawURL); err != nil || (tURLPtr.Scheme != "http" && tURLPtr.Scheme != "https") || tURLPtr.Host == ctv.VAL_EMPTY {
+		errorInfo = pi.NewErrorInfo(ErrURLInvalid, fmt.Sprintf("%v%v", TXT_URL, rawURL))
+	}
+
+	return
+}
@@ -0,0 +1,136 @@
+package src
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	pi "github.com/sty-holdings/sty-shared/v2024/programInfo"
+)
+
+func TestValidateCheckoutLineItem(t *testing.T) {
+
+	var (
+		tAmount  = &Money{Amount: 1999, Currency: "usd"}
+		tMonthly = &PriceRecurring{Interval: PRICE_INTERVAL_MONTH}
+	)
+
+	tests := []struct {
+		name     string
+		lineItem CheckoutLineItemRequest
+		mode     string
+		wantErr  error
+	}{
+		{name: "price", lineItem: CheckoutLineItemRequest{PriceId: "price_1"}, mode: CHECKOUT_MODE_PAYMENT},
+		{name: "amount", lineItem: CheckoutLineItemRequest{Amount: tAmount, Name: "T-shirt"}, mode: CHECKOUT_MODE_PAYMENT},
+		{name: "recurring amount", lineItem: CheckoutLineItemRequest{Amount: tAmount, Name: "Pro", Recurring: tMonthly}, mode: CHECKOUT_MODE_SUBSCRIPTION},
+		{name: "price in subscription mode", lineItem: CheckoutLineItemRequest{PriceId: "price_1"}, mode: CHECKOUT_MODE_SUBSCRIPTION},
+		{name: "neither price nor amount", lineItem: CheckoutLineItemRequest{Name: "T-shirt"}, mode: CHECKOUT_MODE_PAYMENT, wantErr: pi.ErrRequiredArgumentMissing},
+		{name: "price and amount", lineItem: CheckoutLineItemRequest{Amount: tAmount, Name: "T-shirt", PriceId: "price_1"}, mode: CHECKOUT_MODE_PAYMENT, wantErr: ErrAmountAndPriceSet},
+		{name: "amount without a name", lineItem: CheckoutLineItemRequest{Amount: tAmount}, mode: CHECKOUT_MODE_PAYMENT, wantErr: pi.ErrRequiredArgumentMissing},
+		{name: "zero amount", lineItem: CheckoutLineItemRequest{Amount: &Money{Currency: "usd"}, Name: "T-shirt"}, mode: CHECKOUT_MODE_PAYMENT, wantErr: ErrAmountNotPositive},
+		{name: "amount without recurring in subscription mode", lineItem: CheckoutLineItemRequest{Amount: tAmount, Name: "Pro"}, mode: CHECKOUT_MODE_SUBSCRIPTION, wantErr: pi.ErrRequiredArgumentMissing},
+		{name: "invalid interval", lineItem: CheckoutLineItemRequest{Amount: tAmount, Name: "Pro", Recurring: &PriceRecurring{Interval: "fortnight"}}, mode: CHECKOUT_MODE_SUBSCRIPTION, wantErr: ErrIntervalInvalid},
+		{name: "negative quantity", lineItem: CheckoutLineItemRequest{PriceId: "price_1", Quantity: -1}, mode: CHECKOUT_MODE_PAYMENT, wantErr: ErrQuantityInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if errorInfo := validateCheckoutLineItem(tt.lineItem, tt.mode); errors.Is(errorInfo.Error, tt.wantErr) == false {
+				t.Errorf("validateCheckoutLineItem(%+v, %v) error = %v, want %v", tt.lineItem, tt.mode, errorInfo.Error, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCheckoutLineItemRequestMarshalJSON(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		lineItem CheckoutLineItemRequest
+		want     string
+	}{
+		{name: "price with the default quantity", lineItem: CheckoutLineItemRequest{PriceId: "price_1"}, want: `{"price":"price_1","quantity":1}`},
+		{name: "price with a quantity", lineItem: CheckoutLineItemRequest{PriceId: "price_1", Quantity: 3}, want: `{"price":"price_1","quantity":3}`},
+		{
+			name:     "amount",
+			lineItem: CheckoutLineItemRequest{Amount: &Money{Amount: 1999, Currency: "usd"}, Name: "T-shirt"},
+			want:     `{"price_data":{"currency":"usd","product_data":{"name":"T-shirt"},"unit_amount":19.99},"quantity":1}`,
+		},
+		{
+			name:     "recurring amount",
+			lineItem: CheckoutLineItemRequest{Amount: &Money{Amount: 500, Currency: "jpy"}, Name: "Pro", Quantity: 2, Recurring: &PriceRecurring{Interval: PRICE_INTERVAL_MONTH}},
+			want:     `{"price_data":{"currency":"jpy","product_data":{"name":"Pro"},"recurring":{"interval":"month"},"unit_amount":500},"quantity":2}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.lineItem)
+			if err != nil {
+				t.Fatalf("json.Marshal(%+v) error = %v", tt.lineItem, err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("json.Marshal(%+v) = %s, want %s", tt.lineItem, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCreateCheckoutSessionURLs(t *testing.T) {
+
+	var (
+		// A closed client returns ErrClientClosed once the request is valid, so nothing is sent.
+		tClientPtr = &Ai2CClient{closed: true}
+	)
+
+	tests := []struct {
+		name    string
+		request CreateCheckoutSessionRequest
+		wantErr error
+	}{
+		{name: "hosted with a success URL", request: CreateCheckoutSessionRequest{SuccessURL: "https://example.com/done"}, wantErr: ErrClientClosed},
+		{name: "hosted without a success URL", request: CreateCheckoutSessionRequest{ReturnURL: "https://example.com/done"}, wantErr: pi.ErrRequiredArgumentMissing},
+		{name: "embedded with a return URL", request: CreateCheckoutSessionRequest{UIMode: CHECKOUT_UI_MODE_EMBEDDED, ReturnURL: "https://example.com/done"}, wantErr: ErrClientClosed},
+		{name: "embedded without a return URL", request: CreateCheckoutSessionRequest{UIMode: CHECKOUT_UI_MODE_EMBEDDED, SuccessURL: "https://example.com/done"}, wantErr: pi.ErrRequiredArgumentMissing},
+		{name: "invalid UI mode", request: CreateCheckoutSessionRequest{UIMode: "popup", SuccessURL: "https://example.com/done"}, wantErr: ErrUIModeInvalid},
+		{name: "relative success URL", request: CreateCheckoutSessionRequest{SuccessURL: "/done"}, wantErr: ErrURLInvalid},
+		{name: "non-http cancel URL", request: CreateCheckoutSessionRequest{SuccessURL: "https://example.com/done", CancelURL: "javascript:alert(1)"}, wantErr: ErrURLInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.request.SaaSKey, tt.request.Mode = "sk_test", CHECKOUT_MODE_SETUP
+			if _, errorInfo := tClientPtr.CreateCheckoutSession(context.Background(), tt.request); errors.Is(errorInfo.Error, tt.wantErr) == false {
+				t.Errorf("CreateCheckoutSession(%+v) error = %v, want %v", tt.request, errorInfo.Error, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateURL(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		rawURL  string
+		wantErr error
+	}{
+		{name: "empty", rawURL: ""},
+		{name: "https", rawURL: "https://example.com/checkout/done?session_id={CHECKOUT_SESSION_ID}"},
+		{name: "http", rawURL: "http://localhost:8080/done"},
+		{name: "relative", rawURL: "/done", wantErr: ErrURLInvalid},
+		{name: "no host", rawURL: "https:///done", wantErr: ErrURLInvalid},
+		{name: "ftp", rawURL: "ftp://example.com/done", wantErr: ErrURLInvalid},
+		{name: "javascript", rawURL: "javascript:alert(1)", wantErr: ErrURLInvalid},
+		{name: "unparsable", rawURL: "https://exa mple.com/%zz", wantErr: ErrURLInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if errorInfo := validateURL(tt.rawURL); errors.Is(errorInfo.Error, tt.wantErr) == false {
+				t.Errorf("validateURL(%q) error = %v, want %v", tt.rawURL, errorInfo.Error, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,366 @@
+// Package src
+/*
+These are the coupon operations of the Ai2CClient.
+
+RESTRICTIONS:
+	None
+
+NOTES:
+    A coupon is a discount of a percentage or an amount. Duration sets how long it applies to a subscription: once,
+    for DurationInMonths with repeating, or forever. A coupon is applied with a DiscountRequest when creating a
+    subscription or a checkout session, either directly or through a promotion code, see promotion-codes.go.
+
+    Only the name and metadata of a coupon can be changed. Deleting a coupon stops new redemptions, but the
+    discounts already applied stay in place.
+
+COPYRIGHT:
+	Copyright 2022
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+
+*/
+package src
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	ctv "github.com/sty-holdings/constant-type-vars-go/v2024"
+	pi "github.com/sty-holdings/sty-shared/v2024/programInfo"
+)
+
+//goland:noinspection ALL
+const (
+	COUPON_DURATION_FOREVER   = "forever"
+	COUPON_DURATION_ONCE      = "once"
+	COUPON_DURATION_REPEATING = "repeating"
+)
+
+//goland:noinspection ALL
+const (
+	FN_COUPON_ID          = "coupon_id"
+	FN_DURATION_IN_MONTHS = "duration_in_months"
+)
+
+//goland:noinspection ALL
+const (
+	SUB_STRIPE_CREATE_COUPON = "stripe.coupon.create"
+	SUB_STRIPE_DELETE_COUPON = "stripe.coupon.delete"
+	SUB_STRIPE_GET_COUPON    = "stripe.coupon.get"
+	SUB_STRIPE_LIST_COUPONS  = "stripe.coupon.list"
+	SUB_STRIPE_UPDATE_COUPON = "stripe.coupon.update"
+)
+
+//goland:noinspection ALL
+const (
+	TXT_COUPON      = "Coupon: "
+	TXT_DISCOUNT    = "Discount: "
+	TXT_DURATION    = "Duration: "
+	TXT_PERCENT_OFF = "Percent off: "
+)
+
+var (
+	ErrCouponDiscountInvalid       = errors.New("exactly one of the amount off and the percent off must be set")
+	ErrDiscountInvalid             = errors.New("a discount must have exactly one of a coupon and a promotion code")
+	ErrDiscountsWithPromotionCodes = errors.New("discounts cannot be set when promotion codes are allowed")
+	ErrDurationInvalid             = errors.New("the duration must be forever, once, or repeating")
+	ErrPercentOffOutOfRange        = errors.New("the percent off must be greater than 0 and not more than 100")
+)
+
+type Coupon struct {
+	Id               string            `json:"id"`
+	Object           string            `json:"object,omitempty"`
+	AmountOff        int64             `json:"amount_off,omitempty"`
+	AppliesTo        *CouponAppliesTo  `json:"applies_to,omitempty"`
+	Created          int64             `json:"created,omitempty"`
+	Currency         string            `json:"currency,omitempty"`
+	Duration         string            `json:"duration"`
+	DurationInMonths int64             `json:"duration_in_months,omitempty"`
+	LiveMode         bool              `json:"livemode,omitempty"`
+	MaxRedemptions   int64             `json:"max_redemptions,omitempty"`
+	Metadata         map[string]string `json:"metadata,omitempty"`
+	Name             string            `json:"name,omitempty"`
+	PercentOff       float64           `json:"percent_off,omitempty"`
+	RedeemBy         int64             `json:"redeem_by,omitempty"`
+	TimesRedeemed    int64             `json:"times_redeemed,omitempty"`
+	Valid            bool              `json:"valid"`
+	RawReply
+}
+
+// CouponAppliesTo - the ids of the products the coupon is limited to.
+type CouponAppliesTo struct {
+	ProductIds []string `json:"products"`
+}
+
+type CouponList = List[Coupon]
+
+// CreateCouponRequest - exactly one of AmountOff and PercentOff is required. CouponId is optional and is the code
+// used to apply the coupon. When it is empty, an id is generated. MaxRedemptions and RedeemBy, a Unix timestamp,
+// limit how many times and until when the coupon can be applied.
+type CreateCouponRequest struct {
+	SaaSKey          string            `json:"saas_key"`
+	CouponId         string            `json:"id,omitempty"`
+	AmountOff        *Money            `json:"-"`
+	AppliesTo        *CouponAppliesTo  `json:"applies_to,omitempty"`
+	Duration         string            `json:"duration,omitempty"`
+	DurationInMonths int64             `json:"duration_in_months,omitempty"`
+	MaxRedemptions   int64             `json:"max_redemptions,omitempty"`
+	Metadata         map[string]string `json:"metadata,omitempty"`
+	Name             string            `json:"name,omitempty"`
+	PercentOff       float64           `json:"percent_off,omitempty"`
+	RedeemBy         int64             `json:"redeem_by,omitempty"`
+}
+
+type DeleteCouponRequest struct {
+	SaaSKey  string `json:"saas_key"`
+	CouponId string `json:"id"`
+}
+
+// DiscountRequest - applies a coupon, with CouponId, or a promotion code, with PromotionCodeId. Only one of them
+// can be set.
+type DiscountRequest struct {
+	CouponId        string `json:"coupon,omitempty"`
+	PromotionCodeId string `json:"promotion_code,omitempty"`
+}
+
+type GetCouponRequest struct {
+	SaaSKey  string `json:"saas_key"`
+	CouponId string `json:"id"`
+}
+
+// ListCouponsRequest - Created only returns the coupons created in the date range.
+type ListCouponsRequest struct {
+	SaaSKey       string     `json:"saas_key"`
+	Created       *DateRange `json:"created,omitempty"`
+	Limit         int64      `json:"limit,omitempty"`
+	StartingAfter string     `json:"starting_after,omitempty"`
+}
+
+// UpdateCouponRequest - only the fields that are set are changed. Metadata keys are added or replaced, and a key
+// with an empty value is removed.
+type UpdateCouponRequest struct {
+	SaaSKey  string            `json:"saas_key"`
+	CouponId string            `json:"id"`
+	Metadata map[string]string `json:"metadata,omitempty"`
+	Name     *string           `json:"name,omitempty"`
+}
+
+// CreateCoupon - creates a coupon. The SaaSKey and one of AmountOff and PercentOff are required. PercentOff must be
+// greater than 0 and not more than 100. The repeating duration requires DurationInMonths.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing, ErrCouponDiscountInvalid, ErrAmountNotPositive, ErrCurrencyInvalid,
+// ErrPercentOffOutOfRange, ErrDurationInvalid
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) CreateCoupon(ctx context.Context, request CreateCouponRequest) (
+	coupon Coupon,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if (request.AmountOff == nil) == (request.PercentOff == 0) {
+		errorInfo = pi.NewErrorInfo(ErrCouponDiscountInvalid, fmt.Sprintf("%v%v", TXT_COUPON, request.CouponId))
+		return
+	}
+	if request.AmountOff != nil {
+		if errorInfo = validateAmount(*request.AmountOff); errorInfo.Error != nil {
+			return
+		}
+	}
+	if request.PercentOff < 0 || request.PercentOff > 100 {
+		errorInfo = pi.NewErrorInfo(ErrPercentOffOutOfRange, fmt.Sprintf("%v%v", TXT_PERCENT_OFF, request.PercentOff))
+		return
+	}
+	switch request.Duration {
+	case ctv.VAL_EMPTY, COUPON_DURATION_FOREVER, COUPON_DURATION_ONCE:
+	case COUPON_DURATION_REPEATING:
+		if request.DurationInMonths <= 0 {
+			errorInfo = missingParameter(FN_DURATION_IN_MONTHS)
+			return
+		}
+	default:
+		errorInfo = pi.NewErrorInfo(ErrDurationInvalid, fmt.Sprintf("%v%v", TXT_DURATION, request.Duration))
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_CREATE_COUPON, request, &coupon)
+
+	return
+}
+
+// DeleteCoupon - deletes the coupon identified by CouponId. The SaaSKey and CouponId are required.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) DeleteCoupon(ctx context.Context, request DeleteCouponRequest) (
+	deletedResult DeletedResult,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if request.CouponId == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_COUPON_ID)
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_DELETE_COUPON, request, &deletedResult)
+
+	return
+}
+
+// GetCoupon - returns the coupon identified by CouponId. The SaaSKey and CouponId are required.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) GetCoupon(ctx context.Context, request GetCouponRequest) (
+	coupon Coupon,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if request.CouponId == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_COUPON_ID)
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_GET_COUPON, request, &coupon)
+
+	return
+}
+
+// ListCoupons - lists coupons, newest first. The SaaSKey is required and the Limit must be set to a value between 1
+// and 100. StartingAfter is the id of the coupon the list starts after. Use CouponList.Cursor to get the next page.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing, ErrLimitOutOfRange, ErrDateRangeInvalid
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) ListCoupons(ctx context.Context, request ListCouponsRequest) (
+	couponList CouponList,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if errorInfo = validateListLimit(request.Limit); errorInfo.Error != nil {
+		return
+	}
+	if errorInfo = validateDateRange(request.Created); errorInfo.Error != nil {
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_LIST_COUPONS, request, &couponList)
+
+	return
+}
+
+// UpdateCoupon - changes the coupon identified by CouponId and returns the updated coupon. The SaaSKey, CouponId,
+// and at least one change are required.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing, ErrNoChanges
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) UpdateCoupon(ctx context.Context, request UpdateCouponRequest) (
+	coupon Coupon,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if request.CouponId == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_COUPON_ID)
+		return
+	}
+	if len(request.Metadata) == ctv.VAL_ZERO && request.Name == nil {
+		errorInfo = pi.NewErrorInfo(ErrNoChanges, fmt.Sprintf("%v%v", TXT_COUPON, request.CouponId))
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_UPDATE_COUPON, request, &coupon)
+
+	return
+}
+
+// MarshalJSON - encodes the request with AmountOff as a decimal in the major unit and the currency next to it. Both
+// are left out when AmountOff is nil.
+//
+// Customer Messages: None
+// Errors: json errors
+// Verifications: None
+func (request CreateCouponRequest) MarshalJSON() ([]byte, error) {
+
+	type tCreateCouponRequest CreateCouponRequest
+
+	var (
+		tAmountOff json.Number
+		tCurrency  string
+	)
+
+	tAmountOff, tCurrency = decimalAmount(request.AmountOff)
+
+	return json.Marshal(struct {
+		tCreateCouponRequest
+		AmountOff json.Number `json:"amount_off,omitempty"`
+		Currency  string      `json:"currency,omitempty"`
+	}{
+		tCreateCouponRequest: tCreateCouponRequest(request),
+		AmountOff:            tAmountOff,
+		Currency:             tCurrency,
+	})
+}
+
+// AmountOffMoney - returns AmountOff, which is in minor units, with the currency as Money. It is zero for a percent
+// off coupon.
+//
+// Customer Messages: None
+// Errors: None
+// Verifications: None
+func (couponPtr *Coupon) AmountOffMoney() (amountOff Money) {
+
+	return replyMoney(couponPtr.AmountOff, couponPtr.Currency)
+}
+
+// Private Function below here
+
+// validateDiscounts - returns ErrDiscountInvalid unless each discount has exactly one of a CouponId and a
+// PromotionCodeId.
+//
+//	Customer Messages: None
+//	Errors: ErrDiscountInvalid
+//	Verifications: None
+func validateDiscounts(discounts []DiscountRequest) (errorInfo pi.ErrorInfo) {
+
+	for _, discount := range discounts {
+		if (discount.CouponId == ctv.VAL_EMPTY) == (discount.PromotionCodeId == ctv.VAL_EMPTY) {
+			errorInfo = pi.NewErrorInfo(ErrDiscountInvalid, fmt.Sprintf("%v%+v", TXT_DISCOUNT, discount))
+			return
+		}
+	}
+
+	return
+}
@@ -0,0 +1,119 @@
+package src
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	pi "github.com/sty-holdings/sty-shared/v2024/programInfo"
+)
+
+func TestCreateCouponValidation(t *testing.T) {
+
+	var (
+		tAmountOff = &Money{Amount: 500, Currency: "usd"}
+		// A closed client returns ErrClientClosed once the request is valid, so nothing is sent.
+		tClientPtr = &Ai2CClient{closed: true}
+	)
+
+	tests := []struct {
+		name    string
+		request CreateCouponRequest
+		wantErr error
+	}{
+		{name: "amount off", request: CreateCouponRequest{AmountOff: tAmountOff}, wantErr: ErrClientClosed},
+		{name: "percent off", request: CreateCouponRequest{PercentOff: 25}, wantErr: ErrClientClosed},
+		{name: "percent off of 100", request: CreateCouponRequest{PercentOff: 100}, wantErr: ErrClientClosed},
+		{name: "neither amount nor percent off", request: CreateCouponRequest{}, wantErr: ErrCouponDiscountInvalid},
+		{name: "amount and percent off", request: CreateCouponRequest{AmountOff: tAmountOff, PercentOff: 25}, wantErr: ErrCouponDiscountInvalid},
+		{name: "zero amount off", request: CreateCouponRequest{AmountOff: &Money{Currency: "usd"}}, wantErr: ErrAmountNotPositive},
+		{name: "negative percent off", request: CreateCouponRequest{PercentOff: -5}, wantErr: ErrPercentOffOutOfRange},
+		{name: "percent off over 100", request: CreateCouponRequest{PercentOff: 100.5}, wantErr: ErrPercentOffOutOfRange},
+		{name: "once", request: CreateCouponRequest{PercentOff: 25, Duration: COUPON_DURATION_ONCE}, wantErr: ErrClientClosed},
+		{name: "repeating", request: CreateCouponRequest{PercentOff: 25, Duration: COUPON_DURATION_REPEATING, DurationInMonths: 3}, wantErr: ErrClientClosed},
+		{name: "repeating without months", request: CreateCouponRequest{PercentOff: 25, Duration: COUPON_DURATION_REPEATING}, wantErr: pi.ErrRequiredArgumentMissing},
+		{name: "invalid duration", request: CreateCouponRequest{PercentOff: 25, Duration: "weekly"}, wantErr: ErrDurationInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.request.SaaSKey = "sk_test"
+			if _, errorInfo := tClientPtr.CreateCoupon(context.Background(), tt.request); errors.Is(errorInfo.Error, tt.wantErr) == false {
+				t.Errorf("CreateCoupon(%+v) error = %v, want %v", tt.request, errorInfo.Error, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateDiscounts(t *testing.T) {
+
+	tests := []struct {
+		name      string
+		discounts []DiscountRequest
+		wantErr   error
+	}{
+		{name: "none", discounts: nil},
+		{name: "coupon", discounts: []DiscountRequest{{CouponId: "SUMMER25"}}},
+		{name: "promotion code", discounts: []DiscountRequest{{PromotionCodeId: "promo_1"}}},
+		{name: "empty discount", discounts: []DiscountRequest{{CouponId: "SUMMER25"}, {}}, wantErr: ErrDiscountInvalid},
+		{name: "coupon and promotion code", discounts: []DiscountRequest{{CouponId: "SUMMER25", PromotionCodeId: "promo_1"}}, wantErr: ErrDiscountInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if errorInfo := validateDiscounts(tt.discounts); errors.Is(errorInfo.Error, tt.wantErr) == false {
+				t.Errorf("validateDiscounts(%+v) error = %v, want %v", tt.discounts, errorInfo.Error, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCreateCheckoutSessionDiscounts(t *testing.T) {
+
+	var (
+		tClientPtr = &Ai2CClient{closed: true}
+	)
+
+	tests := []struct {
+		name    string
+		request CreateCheckoutSessionRequest
+		wantErr error
+	}{
+		{name: "discounts", request: CreateCheckoutSessionRequest{Discounts: []DiscountRequest{{CouponId: "SUMMER25"}}}, wantErr: ErrClientClosed},
+		{name: "promotion codes allowed", request: CreateCheckoutSessionRequest{AllowPromotionCodes: true}, wantErr: ErrClientClosed},
+		{
+			name:    "discounts with promotion codes allowed",
+			request: CreateCheckoutSessionRequest{AllowPromotionCodes: true, Discounts: []DiscountRequest{{CouponId: "SUMMER25"}}},
+			wantErr: ErrDiscountsWithPromotionCodes,
+		},
+		{name: "invalid discount", request: CreateCheckoutSessionRequest{Discounts: []DiscountRequest{{}}}, wantErr: ErrDiscountInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.request.SaaSKey, tt.request.Mode, tt.request.SuccessURL = "sk_test", CHECKOUT_MODE_SETUP, "https://example.com/done"
+			if _, errorInfo := tClientPtr.CreateCheckoutSession(context.Background(), tt.request); errors.Is(errorInfo.Error, tt.wantErr) == false {
+				t.Errorf("CreateCheckoutSession(%+v) error = %v, want %v", tt.request, errorInfo.Error, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDeactivatePromotionCodeRequired(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request DeactivatePromotionCodeRequest
+	}{
+		{name: "no saas key", request: DeactivatePromotionCodeRequest{PromotionCodeId: "promo_1"}},
+		{name: "no promotion code", request: DeactivatePromotionCodeRequest{SaaSKey: "sk_test"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, errorInfo := (&Ai2CClient{}).DeactivatePromotionCode(context.Background(), tt.request); errors.Is(errorInfo.Error, pi.ErrRequiredArgumentMissing) == false {
+				t.Errorf("DeactivatePromotionCode(%+v) error = %v, want %v", tt.request, errorInfo.Error, pi.ErrRequiredArgumentMissing)
+			}
+		})
+	}
+}
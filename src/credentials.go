@@ -0,0 +1,137 @@
+// Package src
+/*
+This builds the NATS TLS configuration and user credentials in memory, so the private key and credentials are
+never written to disk.
+
+RESTRICTIONS:
+	None
+
+NOTES:
+    The in-memory mode is used when no temporary directory is set or WithInMemoryCredentials is used.
+
+COPYRIGHT:
+	Copyright 2022
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+
+*/
+package src
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nkeys"
+	ns "github.com/sty-holdings/sty-shared/v2024/natsSerices"
+	pi "github.com/sty-holdings/sty-shared/v2024/programInfo"
+)
+
+//goland:noinspection ALL
+const (
+	TXT_NATS_CREDENTIALS = "NATS credentials: "
+	TXT_TLS_CA_BUNDLE    = "TLS CA bundle: "
+	TXT_TLS_CERTIFICATE  = "TLS certificate: "
+)
+
+var (
+	ErrTLSCABundleInvalid = errors.New("the TLS CA bundle does not contain a PEM certificate")
+)
+
+// WithInMemoryCredentials - keeps the TLS certificate, private key, CA bundle, and NATS credentials in memory even
+// when a temporary directory is set.
+func WithInMemoryCredentials() Option {
+	return func(configPtr *clientConfig) {
+		configPtr.inMemoryCredentials = true
+	}
+}
+
+// Private Function below here
+
+// buildInMemoryOptions - returns the NATS options that supply the TLS configuration and user credentials from the
+// values in natsConfig.
+//
+//	Customer Messages: None
+//	Errors: ErrTLSCABundleInvalid, tls errors, nkeys errors
+//	Verifications: None
+func buildInMemoryOptions(natsConfig ns.NATSConfiguration) (
+	natsOptions []nats.Option,
+	errorInfo pi.ErrorInfo,
+) {
+
+	var (
+		tJWT       string
+		tKeyPair   nkeys.KeyPair
+		tSeed      []byte
+		tTLSConfig *tls.Config
+	)
+
+	if tTLSConfig, errorInfo = buildTLSConfig(natsConfig.NATSTLSInfo.TLSCert, natsConfig.NATSTLSInfo.TLSPrivateKey, natsConfig.NATSTLSInfo.TLSCABundle); errorInfo.Error != nil {
+		return
+	}
+
+	if tJWT, errorInfo.Error = nkeys.ParseDecoratedJWT([]byte(natsConfig.NATSToken)); errorInfo.Error != nil {
+		errorInfo = pi.NewErrorInfo(errorInfo.Error, fmt.Sprintf("%v%v", TXT_NATS_CREDENTIALS, "the user JWT could not be read"))
+		return
+	}
+	if tKeyPair, errorInfo.Error = nkeys.ParseDecoratedNKey([]byte(natsConfig.NATSToken)); errorInfo.Error != nil {
+		errorInfo = pi.NewErrorInfo(errorInfo.Error, fmt.Sprintf("%v%v", TXT_NATS_CREDENTIALS, "the user seed could not be read"))
+		return
+	}
+	defer tKeyPair.Wipe()
+	if tSeed, errorInfo.Error = tKeyPair.Seed(); errorInfo.Error != nil {
+		errorInfo = pi.NewErrorInfo(errorInfo.Error, fmt.Sprintf("%v%v", TXT_NATS_CREDENTIALS, "the user seed could not be read"))
+		return
+	}
+
+	natsOptions = []nats.Option{
+		nats.Secure(tTLSConfig),
+		nats.UserJWTAndSeed(tJWT, string(tSeed)),
+	}
+
+	return
+}
+
+// buildTLSConfig - builds the client TLS configuration from the PEM encoded certificate, private key, and CA bundle.
+//
+//	Customer Messages: None
+//	Errors: ErrTLSCABundleInvalid, tls errors
+//	Verifications: None
+func buildTLSConfig(certificatePEM, privateKeyPEM, caBundlePEM string) (
+	tlsConfigPtr *tls.Config,
+	errorInfo pi.ErrorInfo,
+) {
+
+	var (
+		tCertificate tls.Certificate
+		tRootCAs     = x509.NewCertPool()
+	)
+
+	if tCertificate, errorInfo.Error = tls.X509KeyPair([]byte(certificatePEM), []byte(privateKeyPEM)); errorInfo.Error != nil {
+		errorInfo = pi.NewErrorInfo(errorInfo.Error, fmt.Sprintf("%v%v", TXT_TLS_CERTIFICATE, "the certificate and private key could not be loaded"))
+		return
+	}
+	if tRootCAs.AppendCertsFromPEM([]byte(caBundlePEM)) == false {
+		errorInfo = pi.NewErrorInfo(ErrTLSCABundleInvalid, TXT_TLS_CA_BUNDLE)
+		return
+	}
+
+	tlsConfigPtr = &tls.Config{
+		Certificates: []tls.Certificate{tCertificate},
+		MinVersion:   tls.VersionTLS12,
+		RootCAs:      tRootCAs,
+	}
+
+	return
+}
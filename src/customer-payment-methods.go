@@ -0,0 +1,196 @@
+// Package src
+/*
+These are the operations for the payment methods saved to a customer.
+
+RESTRICTIONS:
+	None
+
+NOTES:
+    ListPaymentMethods returns the payment methods available to the account. These operations work with the payment
+    methods saved to a single customer, for example on a saved cards screen.
+
+    SetDefaultPaymentMethod updates the customer's invoice settings default payment method with UpdateCustomer. The
+    payment method must be attached to the customer first.
+
+COPYRIGHT:
+	Copyright 2022
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+
+*/
+package src
+
+import (
+	"context"
+
+	ctv "github.com/sty-holdings/constant-type-vars-go/v2024"
+	pi "github.com/sty-holdings/sty-shared/v2024/programInfo"
+)
+
+//goland:noinspection ALL
+const (
+	PAYMENT_METHOD_TYPE_ACSS_DEBIT      = "acss_debit"
+	PAYMENT_METHOD_TYPE_CARD            = "card"
+	PAYMENT_METHOD_TYPE_SEPA_DEBIT      = "sepa_debit"
+	PAYMENT_METHOD_TYPE_US_BANK_ACCOUNT = "us_bank_account"
+)
+
+//goland:noinspection ALL
+const (
+	SUB_STRIPE_ATTACH_PAYMENT_METHOD         = "stripe.payment-method.attach"
+	SUB_STRIPE_DETACH_PAYMENT_METHOD         = "stripe.payment-method.detach"
+	SUB_STRIPE_LIST_CUSTOMER_PAYMENT_METHODS = "stripe.payment-method.list-customer"
+)
+
+type AttachPaymentMethodRequest struct {
+	SaaSKey         string `json:"saas_key"`
+	CustomerId      string `json:"customer"`
+	PaymentMethodId string `json:"id"`
+}
+
+type DetachPaymentMethodRequest struct {
+	SaaSKey         string `json:"saas_key"`
+	PaymentMethodId string `json:"id"`
+}
+
+// ListCustomerPaymentMethodsRequest - Type only returns the payment methods of that type, for example
+// PAYMENT_METHOD_TYPE_CARD.
+type ListCustomerPaymentMethodsRequest struct {
+	SaaSKey       string `json:"saas_key"`
+	CustomerId    string `json:"customer"`
+	Type          string `json:"type,omitempty"`
+	Limit         int64  `json:"limit,omitempty"`
+	StartingAfter string `json:"starting_after,omitempty"`
+}
+
+type SetDefaultPaymentMethodRequest struct {
+	SaaSKey         string `json:"saas_key"`
+	CustomerId      string `json:"customer"`
+	PaymentMethodId string `json:"payment_method"`
+}
+
+// AttachPaymentMethod - saves the payment method to the customer and returns the payment method. The SaaSKey,
+// CustomerId, and PaymentMethodId are required.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) AttachPaymentMethod(ctx context.Context, request AttachPaymentMethodRequest) (
+	paymentMethod PaymentMethod,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if request.CustomerId == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_CUSTOMER_ID)
+		return
+	}
+	if request.PaymentMethodId == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_PAYMENT_METHOD)
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_ATTACH_PAYMENT_METHOD, request, &paymentMethod)
+
+	return
+}
+
+// DetachPaymentMethod - removes the payment method from its customer and returns the payment method. It can no
+// longer be used. The SaaSKey and PaymentMethodId are required.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) DetachPaymentMethod(ctx context.Context, request DetachPaymentMethodRequest) (
+	paymentMethod PaymentMethod,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if request.PaymentMethodId == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_PAYMENT_METHOD)
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_DETACH_PAYMENT_METHOD, request, &paymentMethod)
+
+	return
+}
+
+// ListCustomerPaymentMethods - lists the payment methods saved to the customer. The SaaSKey and CustomerId are
+// required and the Limit must be set to a value between 1 and 100. StartingAfter is the id of the payment method
+// the list starts after. Use PaymentMethodList.Cursor to get the next page.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing, ErrLimitOutOfRange
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) ListCustomerPaymentMethods(ctx context.Context, request ListCustomerPaymentMethodsRequest) (
+	paymentMethodList PaymentMethodList,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if request.CustomerId == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_CUSTOMER_ID)
+		return
+	}
+	if errorInfo = validateListLimit(request.Limit); errorInfo.Error != nil {
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_LIST_CUSTOMER_PAYMENT_METHODS, request, &paymentMethodList)
+
+	return
+}
+
+// SetDefaultPaymentMethod - makes the payment method the customer's default for invoices and subscriptions and
+// returns the updated customer. The SaaSKey, CustomerId, and PaymentMethodId are required.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing, Errors returned by UpdateCustomer
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) SetDefaultPaymentMethod(ctx context.Context, request SetDefaultPaymentMethodRequest) (
+	customer Customer,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if request.CustomerId == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_CUSTOMER_ID)
+		return
+	}
+	if request.PaymentMethodId == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_PAYMENT_METHOD)
+		return
+	}
+
+	return ai2cClientPtr.UpdateCustomer(
+		ctx,
+		UpdateCustomerRequest{
+			SaaSKey:         request.SaaSKey,
+			CustomerId:      request.CustomerId,
+			InvoiceSettings: &CustomerInvoiceSettings{DefaultPaymentMethodId: request.PaymentMethodId},
+		},
+	)
+}
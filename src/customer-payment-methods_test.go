@@ -0,0 +1,75 @@
+package src_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"ai2c-go-client/src"
+	pi "github.com/sty-holdings/sty-shared/v2024/programInfo"
+)
+
+func TestSetDefaultPaymentMethod(t *testing.T) {
+
+	var (
+		tSent src.UpdateCustomerRequest
+	)
+
+	tServerPtr, tClientPtr := newFakeServerClient(t)
+	if errorInfo := tServerPtr.Reply(
+		src.SUB_STRIPE_UPDATE_CUSTOMER,
+		src.Customer{Id: "cus_1", InvoiceSettings: src.CustomerInvoiceSettings{DefaultPaymentMethodId: "pm_1"}},
+	); errorInfo.Error != nil {
+		t.Fatalf("Reply error = %v", errorInfo.Error)
+	}
+
+	tCustomer, errorInfo := tClientPtr.SetDefaultPaymentMethod(
+		context.Background(),
+		src.SetDefaultPaymentMethodRequest{SaaSKey: "sk_test", CustomerId: "cus_1", PaymentMethodId: "pm_1"},
+	)
+	if errorInfo.Error != nil {
+		t.Fatalf("SetDefaultPaymentMethod error = %v", errorInfo.Error)
+	}
+	if tCustomer.InvoiceSettings.DefaultPaymentMethodId != "pm_1" {
+		t.Errorf("SetDefaultPaymentMethod default payment method = %q, want %q", tCustomer.InvoiceSettings.DefaultPaymentMethodId, "pm_1")
+	}
+
+	tRequests := tServerPtr.Requests()
+	if len(tRequests) != 1 || tRequests[0].Subject != src.SUB_STRIPE_UPDATE_CUSTOMER {
+		t.Fatalf("requests = %+v, want one %v request", tRequests, src.SUB_STRIPE_UPDATE_CUSTOMER)
+	}
+	if err := json.Unmarshal(tRequests[0].Data, &tSent); err != nil {
+		t.Fatalf("json.Unmarshal(%s) error = %v", tRequests[0].Data, err)
+	}
+	if tSent.CustomerId != "cus_1" || tSent.InvoiceSettings == nil || tSent.InvoiceSettings.DefaultPaymentMethodId != "pm_1" {
+		t.Errorf("sent %s, want the payment method in the invoice settings", tRequests[0].Data)
+	}
+}
+
+func TestSetDefaultPaymentMethodRequired(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request src.SetDefaultPaymentMethodRequest
+	}{
+		{name: "no saas key", request: src.SetDefaultPaymentMethodRequest{CustomerId: "cus_1", PaymentMethodId: "pm_1"}},
+		{name: "no customer", request: src.SetDefaultPaymentMethodRequest{SaaSKey: "sk_test", PaymentMethodId: "pm_1"}},
+		{name: "no payment method", request: src.SetDefaultPaymentMethodRequest{SaaSKey: "sk_test", CustomerId: "cus_1"}},
+	}
+
+	tServerPtr, tClientPtr := newFakeServerClient(t)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, errorInfo := tClientPtr.SetDefaultPaymentMethod(context.Background(), tt.request)
+			if errors.Is(errorInfo.Error, pi.ErrRequiredArgumentMissing) == false {
+				t.Errorf("SetDefaultPaymentMethod(%+v) error = %v, want %v", tt.request, errorInfo.Error, pi.ErrRequiredArgumentMissing)
+			}
+		})
+	}
+
+	if tRequests := tServerPtr.Requests(); len(tRequests) != 0 {
+		t.Errorf("requests = %+v, want none", tRequests)
+	}
+}
@@ -0,0 +1,258 @@
+// Package src
+/*
+These are the customer operations of the Ai2CClient.
+
+RESTRICTIONS:
+	None
+
+NOTES:
+    A customer created here can be used as the CustomerId of a PaymentIntentRequest and ListPaymentIntentRequest.
+
+    Update only changes the fields that are set. The pointer fields distinguish a field that is not changed, nil,
+    from a field that is cleared, a pointer to an empty string.
+
+COPYRIGHT:
+	Copyright 2022
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+
+*/
+package src
+
+import (
+	"context"
+	"fmt"
+
+	ctv "github.com/sty-holdings/constant-type-vars-go/v2024"
+	pi "github.com/sty-holdings/sty-shared/v2024/programInfo"
+)
+
+//goland:noinspection ALL
+const (
+	FN_CUSTOMER_ID = "customer_id"
+)
+
+//goland:noinspection ALL
+const (
+	SUB_STRIPE_CREATE_CUSTOMER = "stripe.customer.create"
+	SUB_STRIPE_DELETE_CUSTOMER = "stripe.customer.delete"
+	SUB_STRIPE_GET_CUSTOMER    = "stripe.customer.get"
+	SUB_STRIPE_LIST_CUSTOMERS  = "stripe.customer.list"
+	SUB_STRIPE_UPDATE_CUSTOMER = "stripe.customer.update"
+)
+
+//goland:noinspection ALL
+const (
+	TXT_CUSTOMER = "Customer: "
+)
+
+type Address struct {
+	City       string `json:"city,omitempty"`
+	Country    string `json:"country,omitempty"`
+	Line1      string `json:"line1,omitempty"`
+	Line2      string `json:"line2,omitempty"`
+	PostalCode string `json:"postal_code,omitempty"`
+	State      string `json:"state,omitempty"`
+}
+
+// CreateCustomerRequest - InvoiceSettings.DefaultPaymentMethodId is used for invoices and subscriptions. It must
+// already be attached to the customer, so it is normally set with UpdateCustomer.
+type CreateCustomerRequest struct {
+	SaaSKey         string                   `json:"saas_key"`
+	Address         *Address                 `json:"address,omitempty"`
+	Description     string                   `json:"description,omitempty"`
+	Email           string                   `json:"email,omitempty"`
+	InvoiceSettings *CustomerInvoiceSettings `json:"invoice_settings,omitempty"`
+	Metadata        map[string]string        `json:"metadata,omitempty"`
+	Name            string                   `json:"name,omitempty"`
+	Phone           string                   `json:"phone,omitempty"`
+}
+
+type Customer struct {
+	Id              string                  `json:"id"`
+	Object          string                  `json:"object,omitempty"`
+	Address         *Address                `json:"address,omitempty"`
+	Balance         int64                   `json:"balance,omitempty"`
+	Created         int64                   `json:"created,omitempty"`
+	Currency        string                  `json:"currency,omitempty"`
+	Delinquent      bool                    `json:"delinquent,omitempty"`
+	Description     string                  `json:"description,omitempty"`
+	Email           string                  `json:"email,omitempty"`
+	InvoiceSettings CustomerInvoiceSettings `json:"invoice_settings,omitempty"`
+	LiveMode        bool                    `json:"livemode,omitempty"`
+	Metadata        map[string]string       `json:"metadata,omitempty"`
+	Name            string                  `json:"name,omitempty"`
+	Phone           string                  `json:"phone,omitempty"`
+	RawReply
+}
+
+type CustomerInvoiceSettings struct {
+	DefaultPaymentMethodId string `json:"default_payment_method,omitempty"`
+}
+
+type CustomerList = List[Customer]
+
+type DeleteCustomerRequest struct {
+	SaaSKey    string `json:"saas_key"`
+	CustomerId string `json:"id"`
+}
+
+type GetCustomerRequest struct {
+	SaaSKey    string `json:"saas_key"`
+	CustomerId string `json:"id"`
+}
+
+// ListCustomersRequest - Email only returns the customers with that email address.
+type ListCustomersRequest struct {
+	SaaSKey       string `json:"saas_key"`
+	Email         string `json:"email,omitempty"`
+	Limit         int64  `json:"limit,omitempty"`
+	StartingAfter string `json:"starting_after,omitempty"`
+}
+
+// UpdateCustomerRequest - only the fields that are set are changed. Address replaces the whole address. Metadata
+// keys are added or replaced, and a key with an empty value is removed.
+type UpdateCustomerRequest struct {
+	SaaSKey         string                   `json:"saas_key"`
+	CustomerId      string                   `json:"id"`
+	Address         *Address                 `json:"address,omitempty"`
+	Description     *string                  `json:"description,omitempty"`
+	Email           *string                  `json:"email,omitempty"`
+	InvoiceSettings *CustomerInvoiceSettings `json:"invoice_settings,omitempty"`
+	Metadata        map[string]string        `json:"metadata,omitempty"`
+	Name            *string                  `json:"name,omitempty"`
+	Phone           *string                  `json:"phone,omitempty"`
+}
+
+// CreateCustomer - creates a customer. The SaaSKey is required.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) CreateCustomer(ctx context.Context, request CreateCustomerRequest) (
+	customer Customer,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_CREATE_CUSTOMER, request, &customer)
+
+	return
+}
+
+// DeleteCustomer - deletes the customer identified by CustomerId. The customer's subscriptions are canceled. The
+// SaaSKey and CustomerId are required.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) DeleteCustomer(ctx context.Context, request DeleteCustomerRequest) (
+	deletedResult DeletedResult,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if request.CustomerId == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_CUSTOMER_ID)
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_DELETE_CUSTOMER, request, &deletedResult)
+
+	return
+}
+
+// GetCustomer - returns the customer identified by CustomerId. The SaaSKey and CustomerId are required.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) GetCustomer(ctx context.Context, request GetCustomerRequest) (
+	customer Customer,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if request.CustomerId == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_CUSTOMER_ID)
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_GET_CUSTOMER, request, &customer)
+
+	return
+}
+
+// ListCustomers - lists customers, newest first. The SaaSKey is required and the Limit must be set to a value
+// between 1 and 100. StartingAfter is the id of the customer the list starts after. Use CustomerList.Cursor to get
+// the next page.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing, ErrLimitOutOfRange
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) ListCustomers(ctx context.Context, request ListCustomersRequest) (
+	customerList CustomerList,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if errorInfo = validateListLimit(request.Limit); errorInfo.Error != nil {
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_LIST_CUSTOMERS, request, &customerList)
+
+	return
+}
+
+// UpdateCustomer - changes the customer identified by CustomerId and returns the updated customer. The SaaSKey,
+// CustomerId, and at least one change are required.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing, ErrNoChanges
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) UpdateCustomer(ctx context.Context, request UpdateCustomerRequest) (
+	customer Customer,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if request.CustomerId == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_CUSTOMER_ID)
+		return
+	}
+	if request.Address == nil && request.Description == nil && request.Email == nil && request.InvoiceSettings == nil &&
+		len(request.Metadata) == ctv.VAL_ZERO && request.Name == nil && request.Phone == nil {
+		errorInfo = pi.NewErrorInfo(ErrNoChanges, fmt.Sprintf("%v%v", TXT_CUSTOMER, request.CustomerId))
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_UPDATE_CUSTOMER, request, &customer)
+
+	return
+}
@@ -0,0 +1,275 @@
+// Package src
+/*
+These are the dispute operations of the Ai2CClient.
+
+RESTRICTIONS:
+	None
+
+NOTES:
+    A dispute, or chargeback, is opened when the customer questions a payment with their card issuer. The disputed
+    amount is withdrawn until the dispute is won. Evidence is added with UpdateDispute and is sent to the issuer when
+    it is submitted, which can only be done once, before EvidenceDetails.DueBy.
+
+    The file fields of DisputeEvidence hold the ids of files uploaded to Stripe with the dispute_evidence purpose.
+    CloseDispute accepts the dispute, which is then lost.
+
+COPYRIGHT:
+	Copyright 2022
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+
+*/
+package src
+
+import (
+	"context"
+	"fmt"
+
+	ctv "github.com/sty-holdings/constant-type-vars-go/v2024"
+	pi "github.com/sty-holdings/sty-shared/v2024/programInfo"
+)
+
+//goland:noinspection ALL
+const (
+	DISPUTE_STATUS_LOST                   = "lost"
+	DISPUTE_STATUS_NEEDS_RESPONSE         = "needs_response"
+	DISPUTE_STATUS_UNDER_REVIEW           = "under_review"
+	DISPUTE_STATUS_WARNING_CLOSED         = "warning_closed"
+	DISPUTE_STATUS_WARNING_NEEDS_RESPONSE = "warning_needs_response"
+	DISPUTE_STATUS_WARNING_UNDER_REVIEW   = "warning_under_review"
+	DISPUTE_STATUS_WON                    = "won"
+)
+
+//goland:noinspection ALL
+const (
+	FN_DISPUTE_ID = "dispute_id"
+)
+
+//goland:noinspection ALL
+const (
+	SUB_STRIPE_CLOSE_DISPUTE  = "stripe.dispute.close"
+	SUB_STRIPE_GET_DISPUTE    = "stripe.dispute.get"
+	SUB_STRIPE_LIST_DISPUTES  = "stripe.dispute.list"
+	SUB_STRIPE_UPDATE_DISPUTE = "stripe.dispute.update"
+)
+
+//goland:noinspection ALL
+const (
+	TXT_DISPUTE = "Dispute: "
+)
+
+type CloseDisputeRequest struct {
+	SaaSKey   string `json:"saas_key"`
+	DisputeId string `json:"id"`
+}
+
+type Dispute struct {
+	Id                 string                 `json:"id"`
+	Object             string                 `json:"object,omitempty"`
+	Amount             int64                  `json:"amount"`
+	ChargeId           string                 `json:"charge,omitempty"`
+	Created            int64                  `json:"created,omitempty"`
+	Currency           string                 `json:"currency"`
+	Evidence           DisputeEvidence        `json:"evidence,omitempty"`
+	EvidenceDetails    DisputeEvidenceDetails `json:"evidence_details,omitempty"`
+	IsChargeRefundable bool                   `json:"is_charge_refundable,omitempty"`
+	LiveMode           bool                   `json:"livemode,omitempty"`
+	Metadata           map[string]string      `json:"metadata,omitempty"`
+	PaymentIntentId    string                 `json:"payment_intent,omitempty"`
+	Reason             string                 `json:"reason,omitempty"`
+	Status             string                 `json:"status"`
+	RawReply
+}
+
+// DisputeEvidence - the fields ending in FileId are the ids of uploaded files. The other fields are text. Dates,
+// such as ServiceDate and ShippingDate, are free text.
+type DisputeEvidence struct {
+	AccessActivityLog                  string `json:"access_activity_log,omitempty"`
+	BillingAddress                     string `json:"billing_address,omitempty"`
+	CancellationPolicyDisclosure       string `json:"cancellation_policy_disclosure,omitempty"`
+	CancellationPolicyFileId           string `json:"cancellation_policy,omitempty"`
+	CancellationRebuttal               string `json:"cancellation_rebuttal,omitempty"`
+	CustomerCommunicationFileId        string `json:"customer_communication,omitempty"`
+	CustomerEmailAddress               string `json:"customer_email_address,omitempty"`
+	CustomerName                       string `json:"customer_name,omitempty"`
+	CustomerPurchaseIP                 string `json:"customer_purchase_ip,omitempty"`
+	CustomerSignatureFileId            string `json:"customer_signature,omitempty"`
+	DuplicateChargeDocumentationFileId string `json:"duplicate_charge_documentation,omitempty"`
+	DuplicateChargeExplanation         string `json:"duplicate_charge_explanation,omitempty"`
+	DuplicateChargeId                  string `json:"duplicate_charge_id,omitempty"`
+	ProductDescription                 string `json:"product_description,omitempty"`
+	ReceiptFileId                      string `json:"receipt,omitempty"`
+	RefundPolicyDisclosure             string `json:"refund_policy_disclosure,omitempty"`
+	RefundPolicyFileId                 string `json:"refund_policy,omitempty"`
+	RefundRefusalExplanation           string `json:"refund_refusal_explanation,omitempty"`
+	ServiceDate                        string `json:"service_date,omitempty"`
+	ServiceDocumentationFileId         string `json:"service_documentation,omitempty"`
+	ShippingAddress                    string `json:"shipping_address,omitempty"`
+	ShippingCarrier                    string `json:"shipping_carrier,omitempty"`
+	ShippingDate                       string `json:"shipping_date,omitempty"`
+	ShippingDocumentationFileId        string `json:"shipping_documentation,omitempty"`
+	ShippingTrackingNumber             string `json:"shipping_tracking_number,omitempty"`
+	UncategorizedFileId                string `json:"uncategorized_file,omitempty"`
+	UncategorizedText                  string `json:"uncategorized_text,omitempty"`
+}
+
+// DisputeEvidenceDetails - DueBy is the Unix timestamp by which the evidence must be submitted.
+type DisputeEvidenceDetails struct {
+	DueBy           int64 `json:"due_by,omitempty"`
+	HasEvidence     bool  `json:"has_evidence,omitempty"`
+	PastDue         bool  `json:"past_due,omitempty"`
+	SubmissionCount int64 `json:"submission_count,omitempty"`
+}
+
+type DisputeList = List[Dispute]
+
+type GetDisputeRequest struct {
+	SaaSKey   string `json:"saas_key"`
+	DisputeId string `json:"id"`
+}
+
+// ListDisputesRequest - ChargeId and PaymentIntentId only return the disputes for that payment. Created only
+// returns the disputes opened in the date range.
+type ListDisputesRequest struct {
+	SaaSKey         string     `json:"saas_key"`
+	ChargeId        string     `json:"charge,omitempty"`
+	Created         *DateRange `json:"created,omitempty"`
+	PaymentIntentId string     `json:"payment_intent,omitempty"`
+	Limit           int64      `json:"limit,omitempty"`
+	StartingAfter   string     `json:"starting_after,omitempty"`
+}
+
+// UpdateDisputeRequest - the Evidence fields that are set are added to the evidence already saved. When Submit is
+// set, the evidence is sent to the card issuer and can no longer be changed. Otherwise, it is only saved.
+type UpdateDisputeRequest struct {
+	SaaSKey   string            `json:"saas_key"`
+	DisputeId string            `json:"id"`
+	Evidence  *DisputeEvidence  `json:"evidence,omitempty"`
+	Metadata  map[string]string `json:"metadata,omitempty"`
+	Submit    bool              `json:"submit,omitempty"`
+}
+
+// CloseDispute - accepts the dispute identified by DisputeId and returns the updated dispute, which is lost. This
+// cannot be undone. The SaaSKey and DisputeId are required.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) CloseDispute(ctx context.Context, request CloseDisputeRequest) (
+	dispute Dispute,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if request.DisputeId == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_DISPUTE_ID)
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_CLOSE_DISPUTE, request, &dispute)
+
+	return
+}
+
+// GetDispute - returns the dispute identified by DisputeId. The SaaSKey and DisputeId are required.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) GetDispute(ctx context.Context, request GetDisputeRequest) (
+	dispute Dispute,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if request.DisputeId == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_DISPUTE_ID)
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_GET_DISPUTE, request, &dispute)
+
+	return
+}
+
+// ListDisputes - lists disputes, newest first. The SaaSKey is required and the Limit must be set to a value between
+// 1 and 100. StartingAfter is the id of the dispute the list starts after. Use DisputeList.Cursor to get the next
+// page.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing, ErrLimitOutOfRange, ErrDateRangeInvalid
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) ListDisputes(ctx context.Context, request ListDisputesRequest) (
+	disputeList DisputeList,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if errorInfo = validateListLimit(request.Limit); errorInfo.Error != nil {
+		return
+	}
+	if errorInfo = validateDateRange(request.Created); errorInfo.Error != nil {
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_LIST_DISPUTES, request, &disputeList)
+
+	return
+}
+
+// UpdateDispute - saves or submits evidence for the dispute identified by DisputeId and returns the updated dispute.
+// The SaaSKey, DisputeId, and evidence, metadata, or Submit are required.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing, ErrNoChanges
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) UpdateDispute(ctx context.Context, request UpdateDisputeRequest) (
+	dispute Dispute,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if request.DisputeId == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_DISPUTE_ID)
+		return
+	}
+	if request.Evidence == nil && len(request.Metadata) == ctv.VAL_ZERO && request.Submit == false {
+		errorInfo = pi.NewErrorInfo(ErrNoChanges, fmt.Sprintf("%v%v", TXT_DISPUTE, request.DisputeId))
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_UPDATE_DISPUTE, request, &dispute)
+
+	return
+}
+
+// AmountMoney - returns Amount, which is in minor units, with the currency as Money.
+//
+// Customer Messages: None
+// Errors: None
+// Verifications: None
+func (disputePtr *Dispute) AmountMoney() (amount Money) {
+
+	return replyMoney(disputePtr.Amount, disputePtr.Currency)
+}
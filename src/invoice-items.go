@@ -0,0 +1,235 @@
+// Package src
+/*
+These are the invoice item operations of the Ai2CClient.
+
+RESTRICTIONS:
+	None
+
+NOTES:
+    An invoice item is a line on a draft invoice. When InvoiceId is not set, the item is pending and is added to the
+    customer's next invoice, for example the next subscription invoice.
+
+    A negative amount is a credit.
+
+COPYRIGHT:
+	Copyright 2022
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+
+*/
+package src
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	ctv "github.com/sty-holdings/constant-type-vars-go/v2024"
+	pi "github.com/sty-holdings/sty-shared/v2024/programInfo"
+)
+
+//goland:noinspection ALL
+const (
+	FN_INVOICE_ITEM_ID = "invoice_item_id"
+)
+
+//goland:noinspection ALL
+const (
+	SUB_STRIPE_CREATE_INVOICE_ITEM = "stripe.invoice-item.create"
+	SUB_STRIPE_DELETE_INVOICE_ITEM = "stripe.invoice-item.delete"
+	SUB_STRIPE_LIST_INVOICE_ITEMS  = "stripe.invoice-item.list"
+)
+
+var (
+	ErrAmountAndPriceSet = errors.New("only one of the amount and the price can be set")
+)
+
+// CreateInvoiceItemRequest - the line is either Amount or Quantity units of PriceId. InvoiceId adds the item to that
+// draft invoice.
+type CreateInvoiceItemRequest struct {
+	SaaSKey     string            `json:"saas_key"`
+	CustomerId  string            `json:"customer"`
+	InvoiceId   string            `json:"invoice,omitempty"`
+	Amount      *Money            `json:"-"`
+	Description string            `json:"description,omitempty"`
+	Metadata    map[string]string `json:"metadata,omitempty"`
+	PriceId     string            `json:"price,omitempty"`
+	Quantity    int64             `json:"quantity,omitempty"`
+}
+
+// DeleteInvoiceItemRequest - only an item that is pending or on a draft invoice can be deleted.
+type DeleteInvoiceItemRequest struct {
+	SaaSKey       string `json:"saas_key"`
+	InvoiceItemId string `json:"id"`
+}
+
+type InvoiceItem struct {
+	Id             string            `json:"id"`
+	Object         string            `json:"object,omitempty"`
+	Amount         int64             `json:"amount"`
+	Currency       string            `json:"currency"`
+	CustomerId     string            `json:"customer,omitempty"`
+	Date           int64             `json:"date,omitempty"`
+	Description    string            `json:"description,omitempty"`
+	InvoiceId      string            `json:"invoice,omitempty"`
+	LiveMode       bool              `json:"livemode,omitempty"`
+	Metadata       map[string]string `json:"metadata,omitempty"`
+	Period         InvoicePeriod     `json:"period,omitempty"`
+	Price          *Price            `json:"price,omitempty"`
+	Proration      bool              `json:"proration,omitempty"`
+	Quantity       int64             `json:"quantity,omitempty"`
+	SubscriptionId string            `json:"subscription,omitempty"`
+	RawReply
+}
+
+type InvoiceItemList = List[InvoiceItem]
+
+// ListInvoiceItemsRequest - CustomerId and InvoiceId only return the items that match them. Pending only returns
+// the items that are, true, or are not, false, waiting for the customer's next invoice.
+type ListInvoiceItemsRequest struct {
+	SaaSKey       string `json:"saas_key"`
+	CustomerId    string `json:"customer,omitempty"`
+	InvoiceId     string `json:"invoice,omitempty"`
+	Pending       *bool  `json:"pending,omitempty"`
+	Limit         int64  `json:"limit,omitempty"`
+	StartingAfter string `json:"starting_after,omitempty"`
+}
+
+// CreateInvoiceItem - adds a line to the draft invoice, or to the customer's next invoice when InvoiceId is not set.
+// The SaaSKey, CustomerId, and one of Amount and PriceId are required.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing, ErrAmountAndPriceSet, ErrCurrencyInvalid, ErrQuantityInvalid
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) CreateInvoiceItem(ctx context.Context, request CreateInvoiceItemRequest) (
+	invoiceItem InvoiceItem,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if request.CustomerId == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_CUSTOMER_ID)
+		return
+	}
+	if request.Amount == nil && request.PriceId == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_AMOUNT)
+		return
+	}
+	if request.Amount != nil && request.PriceId != ctv.VAL_EMPTY {
+		errorInfo = pi.NewErrorInfo(ErrAmountAndPriceSet, fmt.Sprintf("%v%v", TXT_AMOUNT, *request.Amount))
+		return
+	}
+	if request.Amount != nil && isCurrencyValid(request.Amount.Currency) == false {
+		errorInfo = pi.NewErrorInfo(ErrCurrencyInvalid, fmt.Sprintf("%v%v", TXT_CURRENCY, request.Amount.Currency))
+		return
+	}
+	if request.Quantity < 0 {
+		errorInfo = pi.NewErrorInfo(ErrQuantityInvalid, fmt.Sprintf("%v%v", TXT_QUANTITY, request.Quantity))
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_CREATE_INVOICE_ITEM, request, &invoiceItem)
+
+	return
+}
+
+// DeleteInvoiceItem - deletes the invoice item identified by InvoiceItemId. The SaaSKey and InvoiceItemId are
+// required.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) DeleteInvoiceItem(ctx context.Context, request DeleteInvoiceItemRequest) (
+	deletedResult DeletedResult,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if request.InvoiceItemId == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_INVOICE_ITEM_ID)
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_DELETE_INVOICE_ITEM, request, &deletedResult)
+
+	return
+}
+
+// ListInvoiceItems - lists invoice items, newest first. The SaaSKey is required and the Limit must be set to a value
+// between 1 and 100. StartingAfter is the id of the invoice item the list starts after. Use InvoiceItemList.Cursor
+// to get the next page.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing, ErrLimitOutOfRange
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) ListInvoiceItems(ctx context.Context, request ListInvoiceItemsRequest) (
+	invoiceItemList InvoiceItemList,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if errorInfo = validateListLimit(request.Limit); errorInfo.Error != nil {
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_LIST_INVOICE_ITEMS, request, &invoiceItemList)
+
+	return
+}
+
+// MarshalJSON - encodes the request with Amount as a decimal in the major unit and the currency next to it. Both
+// are left out when Amount is nil.
+//
+// Customer Messages: None
+// Errors: json errors
+// Verifications: None
+func (request CreateInvoiceItemRequest) MarshalJSON() ([]byte, error) {
+
+	type tCreateInvoiceItemRequest CreateInvoiceItemRequest
+
+	var (
+		tAmount   json.Number
+		tCurrency string
+	)
+
+	tAmount, tCurrency = decimalAmount(request.Amount)
+
+	return json.Marshal(struct {
+		tCreateInvoiceItemRequest
+		Amount   json.Number `json:"amount,omitempty"`
+		Currency string      `json:"currency,omitempty"`
+	}{
+		tCreateInvoiceItemRequest: tCreateInvoiceItemRequest(request),
+		Amount:                    tAmount,
+		Currency:                  tCurrency,
+	})
+}
+
+// AmountMoney - returns Amount, which is in minor units, with the currency as Money.
+//
+// Customer Messages: None
+// Errors: None
+// Verifications: None
+func (invoiceItemPtr *InvoiceItem) AmountMoney() (amount Money) {
+
+	return replyMoney(invoiceItemPtr.Amount, invoiceItemPtr.Currency)
+}
@@ -0,0 +1,448 @@
+// Package src
+/*
+These are the invoice operations of the Ai2CClient.
+
+RESTRICTIONS:
+	None
+
+NOTES:
+    An invoice is created as a draft. Lines are added with CreateInvoiceItem, see invoice-items.go, and the invoice
+    is then finalized, which makes it open and gives it a number. An open invoice is paid, sent to the customer,
+    voided, or marked uncollectible.
+
+    With the charge_automatically collection method, the customer's default payment method is charged. With
+    send_invoice, the customer is emailed the invoice and pays it on the hosted invoice page by the due date.
+
+COPYRIGHT:
+	Copyright 2022
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+
+*/
+package src
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	ctv "github.com/sty-holdings/constant-type-vars-go/v2024"
+	pi "github.com/sty-holdings/sty-shared/v2024/programInfo"
+)
+
+//goland:noinspection ALL
+const (
+	COLLECTION_METHOD_CHARGE_AUTOMATICALLY = "charge_automatically"
+	COLLECTION_METHOD_SEND_INVOICE         = "send_invoice"
+)
+
+//goland:noinspection ALL
+const (
+	INVOICE_STATUS_DRAFT         = "draft"
+	INVOICE_STATUS_OPEN          = "open"
+	INVOICE_STATUS_PAID          = "paid"
+	INVOICE_STATUS_UNCOLLECTIBLE = "uncollectible"
+	INVOICE_STATUS_VOID          = "void"
+)
+
+//goland:noinspection ALL
+const (
+	FN_DAYS_UNTIL_DUE = "days_until_due"
+	FN_INVOICE_ID     = "invoice_id"
+)
+
+//goland:noinspection ALL
+const (
+	SUB_STRIPE_CREATE_INVOICE             = "stripe.invoice.create"
+	SUB_STRIPE_FINALIZE_INVOICE           = "stripe.invoice.finalize"
+	SUB_STRIPE_GET_INVOICE                = "stripe.invoice.get"
+	SUB_STRIPE_LIST_INVOICES              = "stripe.invoice.list"
+	SUB_STRIPE_MARK_INVOICE_UNCOLLECTIBLE = "stripe.invoice.mark-uncollectible"
+	SUB_STRIPE_PAY_INVOICE                = "stripe.invoice.pay"
+	SUB_STRIPE_SEND_INVOICE               = "stripe.invoice.send"
+	SUB_STRIPE_VOID_INVOICE               = "stripe.invoice.void"
+)
+
+//goland:noinspection ALL
+const (
+	TXT_COLLECTION_METHOD = "Collection method: "
+	TXT_INVOICE_STATUS    = "Invoice status: "
+)
+
+var (
+	ErrCollectionMethodInvalid = errors.New("the collection method must be charge_automatically or send_invoice")
+	ErrInvoiceStatusInvalid    = errors.New("the invoice status must be draft, open, paid, uncollectible, or void")
+)
+
+// CreateInvoiceRequest - CollectionMethod defaults to charge_automatically. The send_invoice collection method
+// requires DaysUntilDue or DueDate, a Unix timestamp. When AutoAdvance is set, the invoice is finalized and
+// collected automatically about an hour after it is created.
+type CreateInvoiceRequest struct {
+	SaaSKey                string            `json:"saas_key"`
+	CustomerId             string            `json:"customer"`
+	AutoAdvance            bool              `json:"auto_advance"`
+	CollectionMethod       string            `json:"collection_method,omitempty"`
+	Currency               string            `json:"currency,omitempty"`
+	DaysUntilDue           int64             `json:"days_until_due,omitempty"`
+	DefaultPaymentMethodId string            `json:"default_payment_method,omitempty"`
+	Description            string            `json:"description,omitempty"`
+	DueDate                int64             `json:"due_date,omitempty"`
+	Footer                 string            `json:"footer,omitempty"`
+	Metadata               map[string]string `json:"metadata,omitempty"`
+}
+
+// FinalizeInvoiceRequest - when AutoAdvance is set, the finalized invoice is collected automatically.
+type FinalizeInvoiceRequest struct {
+	SaaSKey     string `json:"saas_key"`
+	InvoiceId   string `json:"id"`
+	AutoAdvance bool   `json:"auto_advance,omitempty"`
+}
+
+type GetInvoiceRequest struct {
+	SaaSKey   string `json:"saas_key"`
+	InvoiceId string `json:"id"`
+}
+
+type Invoice struct {
+	Id                     string            `json:"id"`
+	Object                 string            `json:"object,omitempty"`
+	AmountDue              int64             `json:"amount_due"`
+	AmountPaid             int64             `json:"amount_paid,omitempty"`
+	AmountRemaining        int64             `json:"amount_remaining,omitempty"`
+	AutoAdvance            bool              `json:"auto_advance,omitempty"`
+	CollectionMethod       string            `json:"collection_method,omitempty"`
+	Created                int64             `json:"created,omitempty"`
+	Currency               string            `json:"currency"`
+	CustomerEmail          string            `json:"customer_email,omitempty"`
+	CustomerId             string            `json:"customer,omitempty"`
+	DefaultPaymentMethodId string            `json:"default_payment_method,omitempty"`
+	Description            string            `json:"description,omitempty"`
+	DueDate                int64             `json:"due_date,omitempty"`
+	Footer                 string            `json:"footer,omitempty"`
+	HostedInvoiceURL       string            `json:"hosted_invoice_url,omitempty"`
+	InvoicePDF             string            `json:"invoice_pdf,omitempty"`
+	Lines                  InvoiceLineList   `json:"lines"`
+	LiveMode               bool              `json:"livemode,omitempty"`
+	Metadata               map[string]string `json:"metadata,omitempty"`
+	Number                 string            `json:"number,omitempty"`
+	Paid                   bool              `json:"paid,omitempty"`
+	PaymentIntentId        string            `json:"payment_intent,omitempty"`
+	PeriodEnd              int64             `json:"period_end,omitempty"`
+	PeriodStart            int64             `json:"period_start,omitempty"`
+	Status                 string            `json:"status"`
+	SubscriptionId         string            `json:"subscription,omitempty"`
+	Subtotal               int64             `json:"subtotal,omitempty"`
+	Total                  int64             `json:"total"`
+	RawReply
+}
+
+// InvoiceLine - Type is invoiceitem for a line added with CreateInvoiceItem and subscription for a line billed by
+// a subscription. Amount is in minor units.
+type InvoiceLine struct {
+	Id             string            `json:"id"`
+	Object         string            `json:"object,omitempty"`
+	Amount         int64             `json:"amount"`
+	Currency       string            `json:"currency"`
+	Description    string            `json:"description,omitempty"`
+	InvoiceItemId  string            `json:"invoice_item,omitempty"`
+	Metadata       map[string]string `json:"metadata,omitempty"`
+	Period         InvoicePeriod     `json:"period,omitempty"`
+	Price          *Price            `json:"price,omitempty"`
+	Proration      bool              `json:"proration,omitempty"`
+	Quantity       int64             `json:"quantity,omitempty"`
+	SubscriptionId string            `json:"subscription,omitempty"`
+	Type           string            `json:"type,omitempty"`
+}
+
+type InvoiceLineList = List[InvoiceLine]
+
+type InvoiceList = List[Invoice]
+
+// InvoicePeriod - the Unix timestamps of the start and end of the period a line is billed for.
+type InvoicePeriod struct {
+	End   int64 `json:"end,omitempty"`
+	Start int64 `json:"start,omitempty"`
+}
+
+// ListInvoicesRequest - CustomerId, Status, and SubscriptionId only return the invoices that match them.
+type ListInvoicesRequest struct {
+	SaaSKey        string `json:"saas_key"`
+	CustomerId     string `json:"customer,omitempty"`
+	Status         string `json:"status,omitempty"`
+	SubscriptionId string `json:"subscription,omitempty"`
+	Limit          int64  `json:"limit,omitempty"`
+	StartingAfter  string `json:"starting_after,omitempty"`
+}
+
+type MarkInvoiceUncollectibleRequest struct {
+	SaaSKey   string `json:"saas_key"`
+	InvoiceId string `json:"id"`
+}
+
+// PayInvoiceRequest - PaymentMethodId is charged instead of the invoice's default payment method. When PaidOutOfBand
+// is set, the invoice is marked paid without charging, for example when the customer paid by check.
+type PayInvoiceRequest struct {
+	SaaSKey         string `json:"saas_key"`
+	InvoiceId       string `json:"id"`
+	PaidOutOfBand   bool   `json:"paid_out_of_band,omitempty"`
+	PaymentMethodId string `json:"payment_method,omitempty"`
+}
+
+type SendInvoiceRequest struct {
+	SaaSKey   string `json:"saas_key"`
+	InvoiceId string `json:"id"`
+}
+
+type VoidInvoiceRequest struct {
+	SaaSKey   string `json:"saas_key"`
+	InvoiceId string `json:"id"`
+}
+
+// CreateInvoice - creates a draft invoice for the customer. The SaaSKey and CustomerId are required. When
+// CollectionMethod is send_invoice, DaysUntilDue or DueDate is required.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing, ErrCollectionMethodInvalid, ErrCurrencyInvalid
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) CreateInvoice(ctx context.Context, request CreateInvoiceRequest) (
+	invoice Invoice,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if request.CustomerId == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_CUSTOMER_ID)
+		return
+	}
+	switch request.CollectionMethod {
+	case ctv.VAL_EMPTY, COLLECTION_METHOD_CHARGE_AUTOMATICALLY:
+	case COLLECTION_METHOD_SEND_INVOICE:
+		if request.DaysUntilDue == 0 && request.DueDate == 0 {
+			errorInfo = missingParameter(FN_DAYS_UNTIL_DUE)
+			return
+		}
+	default:
+		errorInfo = pi.NewErrorInfo(ErrCollectionMethodInvalid, fmt.Sprintf("%v%v", TXT_COLLECTION_METHOD, request.CollectionMethod))
+		return
+	}
+	if request.Currency != ctv.VAL_EMPTY && isCurrencyValid(request.Currency) == false {
+		errorInfo = pi.NewErrorInfo(ErrCurrencyInvalid, fmt.Sprintf("%v%v", TXT_CURRENCY, request.Currency))
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_CREATE_INVOICE, request, &invoice)
+
+	return
+}
+
+// FinalizeInvoice - finalizes the draft invoice identified by InvoiceId and returns the open invoice. The SaaSKey and
+// InvoiceId are required.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) FinalizeInvoice(ctx context.Context, request FinalizeInvoiceRequest) (
+	invoice Invoice,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if request.InvoiceId == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_INVOICE_ID)
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_FINALIZE_INVOICE, request, &invoice)
+
+	return
+}
+
+// GetInvoice - returns the invoice identified by InvoiceId. The SaaSKey and InvoiceId are required.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) GetInvoice(ctx context.Context, request GetInvoiceRequest) (
+	invoice Invoice,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if request.InvoiceId == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_INVOICE_ID)
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_GET_INVOICE, request, &invoice)
+
+	return
+}
+
+// ListInvoices - lists invoices, newest first. The SaaSKey is required and the Limit must be set to a value between
+// 1 and 100. When Status is set, it must be one of the INVOICE_STATUS values. StartingAfter is the id of the invoice
+// the list starts after. Use InvoiceList.Cursor to get the next page.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing, ErrLimitOutOfRange, ErrInvoiceStatusInvalid
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) ListInvoices(ctx context.Context, request ListInvoicesRequest) (
+	invoiceList InvoiceList,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if errorInfo = validateListLimit(request.Limit); errorInfo.Error != nil {
+		return
+	}
+	switch request.Status {
+	case ctv.VAL_EMPTY, INVOICE_STATUS_DRAFT, INVOICE_STATUS_OPEN, INVOICE_STATUS_PAID, INVOICE_STATUS_UNCOLLECTIBLE, INVOICE_STATUS_VOID:
+	default:
+		errorInfo = pi.NewErrorInfo(ErrInvoiceStatusInvalid, fmt.Sprintf("%v%v", TXT_INVOICE_STATUS, request.Status))
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_LIST_INVOICES, request, &invoiceList)
+
+	return
+}
+
+// MarkInvoiceUncollectible - marks the open invoice identified by InvoiceId as uncollectible and returns the updated
+// invoice. The SaaSKey and InvoiceId are required.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) MarkInvoiceUncollectible(ctx context.Context, request MarkInvoiceUncollectibleRequest) (
+	invoice Invoice,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if request.InvoiceId == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_INVOICE_ID)
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_MARK_INVOICE_UNCOLLECTIBLE, request, &invoice)
+
+	return
+}
+
+// PayInvoice - pays the open invoice identified by InvoiceId and returns the updated invoice. The SaaSKey and
+// InvoiceId are required.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) PayInvoice(ctx context.Context, request PayInvoiceRequest) (
+	invoice Invoice,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if request.InvoiceId == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_INVOICE_ID)
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_PAY_INVOICE, request, &invoice)
+
+	return
+}
+
+// SendInvoice - emails the open invoice identified by InvoiceId to the customer and returns the invoice. The SaaSKey
+// and InvoiceId are required.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) SendInvoice(ctx context.Context, request SendInvoiceRequest) (
+	invoice Invoice,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if request.InvoiceId == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_INVOICE_ID)
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_SEND_INVOICE, request, &invoice)
+
+	return
+}
+
+// VoidInvoice - voids the open invoice identified by InvoiceId and returns the updated invoice. The SaaSKey and
+// InvoiceId are required.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) VoidInvoice(ctx context.Context, request VoidInvoiceRequest) (
+	invoice Invoice,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if request.InvoiceId == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_INVOICE_ID)
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_VOID_INVOICE, request, &invoice)
+
+	return
+}
+
+// AmountDueMoney - returns AmountDue, which is in minor units, with the currency as Money.
+//
+// Customer Messages: None
+// Errors: None
+// Verifications: None
+func (invoicePtr *Invoice) AmountDueMoney() (amountDue Money) {
+
+	return replyMoney(invoicePtr.AmountDue, invoicePtr.Currency)
+}
+
+// TotalMoney - returns Total, which is in minor units, with the currency as Money.
+//
+// Customer Messages: None
+// Errors: None
+// Verifications: None
+func (invoicePtr *Invoice) TotalMoney() (total Money) {
+
+	return replyMoney(invoicePtr.Total, invoicePtr.Currency)
+}
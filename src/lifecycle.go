@@ -0,0 +1,279 @@
+// Package src
+/*
+This handles shutting down an Ai2CClient.
+
+RESTRICTIONS:
+	None
+
+NOTES:
+    After Close or Drain, every request returns ErrClientClosed. Calling Close or Drain again does nothing.
+
+COPYRIGHT:
+	Copyright 2022
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+
+*/
+package src
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/nats-io/nats.go"
+	ctv "github.com/sty-holdings/constant-type-vars-go/v2024"
+	ns "github.com/sty-holdings/sty-shared/v2024/natsSerices"
+	pi "github.com/sty-holdings/sty-shared/v2024/programInfo"
+)
+
+//goland:noinspection ALL
+const (
+	TXT_TEMPORARY_FILE = "Temporary file: "
+)
+
+var (
+	ErrClientClosed = errors.New("the AI2C client has been closed")
+)
+
+// Close - closes the NATS connection without waiting for in-flight requests and deletes the temporary credential,
+// certificate, and key files.
+//
+// Customer Messages: None
+// Errors: Errors returned when deleting the temporary files
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) Close() (errorInfo pi.ErrorInfo) {
+
+	if ai2cClientPtr.markClosed() == false {
+		return
+	}
+
+	if ai2cClientPtr.natsService.ConnPtr != nil {
+		ai2cClientPtr.natsService.ConnPtr.Close()
+	}
+
+	return ai2cClientPtr.removeTemporaryFiles()
+}
+
+// Drain - stops new requests, waits for the in-flight requests to finish, flushes and closes the NATS connection,
+// and deletes the temporary credential, certificate, and key files. If ctx is done before the in-flight requests
+// finish, the connection is closed anyway and the context error is returned.
+//
+// Customer Messages: None
+// Errors: context.DeadlineExceeded, context.Canceled, Errors returned when deleting the temporary files
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) Drain(ctx context.Context) (errorInfo pi.ErrorInfo) {
+
+	var (
+		tInFlightDone = make(chan struct{})
+	)
+
+	if ai2cClientPtr.markClosed() == false {
+		return
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	go func() {
+		ai2cClientPtr.inFlight.Wait()
+		close(tInFlightDone)
+	}()
+
+	select {
+	case <-tInFlightDone:
+		if ai2cClientPtr.natsService.ConnPtr != nil {
+			// FlushWithContext needs a deadline. Without one, Flush uses the NATS default timeout.
+			if _, ok := ctx.Deadline(); ok {
+				errorInfo.Error = ai2cClientPtr.natsService.ConnPtr.FlushWithContext(ctx)
+			} else {
+				errorInfo.Error = ai2cClientPtr.natsService.ConnPtr.Flush()
+			}
+		}
+	case <-ctx.Done():
+		errorInfo.Error = ctx.Err()
+	}
+	if errorInfo.Error != nil {
+		errorInfo = pi.NewErrorInfo(errorInfo.Error, fmt.Sprintf("%v%v", ctv.TXT_FUNCTION_NAME, "Drain"))
+	}
+
+	if ai2cClientPtr.natsService.ConnPtr != nil {
+		ai2cClientPtr.natsService.ConnPtr.Close()
+	}
+
+	if tErrorInfo := ai2cClientPtr.removeTemporaryFiles(); errorInfo.Error == nil {
+		errorInfo = tErrorInfo
+	}
+
+	return
+}
+
+// Private Function below here
+
+// beginRequest - registers an in-flight request and returns the connection to send it on. It returns ErrClientClosed
+// once Close or Drain has been called. Every successful call must be followed by a call to endRequest with
+// connInFlightPtr, which keeps a replaced connection open until its requests finish.
+//
+//	Customer Messages: None
+//	Errors: ErrClientClosed
+//	Verifications: None
+func (ai2cClientPtr *Ai2CClient) beginRequest() (
+	connPtr *nats.Conn,
+	connInFlightPtr *sync.WaitGroup,
+	errorInfo pi.ErrorInfo,
+) {
+
+	ai2cClientPtr.closedLock.RLock()
+	defer ai2cClientPtr.closedLock.RUnlock()
+
+	if ai2cClientPtr.closed {
+		errorInfo = pi.NewErrorInfo(ErrClientClosed, PROGRAM_NAME)
+		return
+	}
+	ai2cClientPtr.inFlight.Add(1)
+	ai2cClientPtr.connInFlightPtr.Add(1)
+
+	return ai2cClientPtr.natsService.ConnPtr, ai2cClientPtr.connInFlightPtr, errorInfo
+}
+
+// endRequest - releases a request registered with beginRequest.
+//
+//	Customer Messages: None
+//	Errors: None
+//	Verifications: None
+func (ai2cClientPtr *Ai2CClient) endRequest(connInFlightPtr *sync.WaitGroup) {
+
+	connInFlightPtr.Done()
+	ai2cClientPtr.inFlight.Done()
+}
+
+// markClosed - sets the client to closed and stops the token manager. It returns false when the client was
+// already closed. The token manager is stopped after closedLock is released, so new requests are refused right away.
+//
+//	Customer Messages: None
+//	Errors: None
+//	Verifications: None
+func (ai2cClientPtr *Ai2CClient) markClosed() (changed bool) {
+
+	ai2cClientPtr.closedLock.Lock()
+	if ai2cClientPtr.closed {
+		ai2cClientPtr.closedLock.Unlock()
+		return
+	}
+	ai2cClientPtr.closed = true
+	ai2cClientPtr.closedLock.Unlock()
+
+	if ai2cClientPtr.tokenManagerPtr != nil {
+		ai2cClientPtr.tokenManagerPtr.stop()
+	}
+
+	return true
+}
+
+// reconnect - reads the parameters again, using the renewed Cognito ID token, and when they have changed, connects
+// to the NATS service with them. New requests use the new connection. The old one is closed once its in-flight
+// requests finish. Errors are output with printErrorInfo and the current connection is kept.
+//
+//	Customer Messages: None
+//	Errors: None
+//	Verifications: None
+func (ai2cClientPtr *Ai2CClient) reconnect(ctx context.Context) {
+
+	var (
+		tConnPtr            *nats.Conn
+		tCurrentNATSConfig  ns.NATSConfiguration
+		tErrorInfo          pi.ErrorInfo
+		tNATSConfig         ns.NATSConfiguration
+		tOldConnInFlightPtr *sync.WaitGroup
+		tOldConnPtr         *nats.Conn
+	)
+
+	ai2cClientPtr.closedLock.RLock()
+	tCurrentNATSConfig = ai2cClientPtr.natsConfig
+	ai2cClientPtr.closedLock.RUnlock()
+	tNATSConfig = tCurrentNATSConfig
+
+	if tErrorInfo = processClientParameters(ctx, ai2cClientPtr.parameterSource, ai2cClientPtr.environment, &tNATSConfig); tErrorInfo.Error != nil {
+		ai2cClientPtr.printErrorInfo(tErrorInfo)
+		return
+	}
+	if tNATSConfig.NATSToken == tCurrentNATSConfig.NATSToken &&
+		tNATSConfig.NATSURL == tCurrentNATSConfig.NATSURL &&
+		tNATSConfig.NATSPort == tCurrentNATSConfig.NATSPort &&
+		tNATSConfig.NATSTLSInfo.TLSCert == tCurrentNATSConfig.NATSTLSInfo.TLSCert &&
+		tNATSConfig.NATSTLSInfo.TLSPrivateKey == tCurrentNATSConfig.NATSTLSInfo.TLSPrivateKey &&
+		tNATSConfig.NATSTLSInfo.TLSCABundle == tCurrentNATSConfig.NATSTLSInfo.TLSCABundle {
+		return
+	}
+
+	if ai2cClientPtr.inMemoryCredentials == false {
+		if tErrorInfo = buildTemporaryFiles(ai2cClientPtr.tempDirectory, &tNATSConfig); tErrorInfo.Error != nil {
+			ai2cClientPtr.printErrorInfo(tErrorInfo)
+			return
+		}
+	}
+	if tConnPtr, tErrorInfo = getConnection(
+		ai2cClientPtr.natsService.InstanceName, tNATSConfig, ai2cClientPtr.inMemoryCredentials, ai2cClientPtr.natsOptions,
+	); tErrorInfo.Error != nil {
+		ai2cClientPtr.printErrorInfo(tErrorInfo)
+		return
+	}
+
+	ai2cClientPtr.closedLock.Lock()
+	if ai2cClientPtr.closed {
+		ai2cClientPtr.closedLock.Unlock()
+		tConnPtr.Close()
+		return
+	}
+	tOldConnPtr = ai2cClientPtr.natsService.ConnPtr
+	tOldConnInFlightPtr = ai2cClientPtr.connInFlightPtr
+	ai2cClientPtr.natsService.ConnPtr = tConnPtr
+	ai2cClientPtr.connInFlightPtr = &sync.WaitGroup{}
+	ai2cClientPtr.natsConfig = tNATSConfig
+	ai2cClientPtr.closedLock.Unlock()
+
+	go func() {
+		tOldConnInFlightPtr.Wait()
+		tOldConnPtr.Close()
+	}()
+}
+
+// removeTemporaryFiles - deletes the credential, certificate, and key files written into the temporary directory.
+// Files that do not exist are skipped. The first error is returned after trying every file.
+//
+//	Customer Messages: None
+//	Errors: os errors
+//	Verifications: None
+func (ai2cClientPtr *Ai2CClient) removeTemporaryFiles() (errorInfo pi.ErrorInfo) {
+
+	var (
+		tError error
+	)
+
+	for _, fileFQN := range []string{
+		ai2cClientPtr.natsConfig.NATSCredentialsFilename,
+		ai2cClientPtr.natsConfig.NATSTLSInfo.TLSCABundleFQN,
+		ai2cClientPtr.natsConfig.NATSTLSInfo.TLSCertFQN,
+		ai2cClientPtr.natsConfig.NATSTLSInfo.TLSPrivateKeyFQN,
+	} {
+		if fileFQN == ctv.VAL_EMPTY {
+			continue
+		}
+		if tError = os.Remove(fileFQN); tError != nil && errors.Is(tError, os.ErrNotExist) == false && errorInfo.Error == nil {
+			errorInfo = pi.NewErrorInfo(tError, fmt.Sprintf("%v%v", TXT_TEMPORARY_FILE, fileFQN))
+		}
+	}
+
+	return
+}
@@ -0,0 +1,149 @@
+package src_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"ai2c-go-client/src"
+	"ai2c-go-client/src/ai2ctest"
+	ctv "github.com/sty-holdings/constant-type-vars-go/v2024"
+)
+
+func TestDrainWaitsForInFlightRequests(t *testing.T) {
+
+	tServerPtr, tClientPtr := newFakeServerClient(t)
+	tRelease, tResult := startBlockedRequest(t, tServerPtr, tClientPtr)
+
+	tDrained := make(chan error, 1)
+	go func() {
+		tDrained <- tClientPtr.Drain(context.Background()).Error
+	}()
+
+	waitForClosed(t, tClientPtr)
+	select {
+	case err := <-tDrained:
+		t.Fatalf("Drain returned %v before the in-flight request finished", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(tRelease)
+	if err := <-tResult; err != nil {
+		t.Errorf("in-flight request error = %v, want nil", err)
+	}
+	if err := <-tDrained; err != nil {
+		t.Errorf("Drain error = %v, want nil", err)
+	}
+}
+
+func TestDrainDeadlineClosesConnection(t *testing.T) {
+
+	tServerPtr, tClientPtr := newFakeServerClient(t)
+	tRelease, tResult := startBlockedRequest(t, tServerPtr, tClientPtr)
+	defer close(tRelease)
+
+	tCtx, tCancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer tCancel()
+
+	if errorInfo := tClientPtr.Drain(tCtx); errors.Is(errorInfo.Error, context.DeadlineExceeded) == false {
+		t.Errorf("Drain error = %v, want %v", errorInfo.Error, context.DeadlineExceeded)
+	}
+	if err := <-tResult; err == nil {
+		t.Error("in-flight request error = nil, want an error after the connection was closed")
+	}
+}
+
+func TestCloseDoesNotWaitForInFlightRequests(t *testing.T) {
+
+	tServerPtr, tClientPtr := newFakeServerClient(t)
+	tRelease, tResult := startBlockedRequest(t, tServerPtr, tClientPtr)
+	defer close(tRelease)
+
+	if errorInfo := tClientPtr.Close(); errorInfo.Error != nil {
+		t.Fatalf("Close error = %v", errorInfo.Error)
+	}
+	select {
+	case err := <-tResult:
+		if err == nil {
+			t.Error("in-flight request error = nil, want an error after the connection was closed")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("the in-flight request did not fail after Close")
+	}
+
+	if errorInfo := tClientPtr.Close(); errorInfo.Error != nil {
+		t.Errorf("second Close error = %v, want nil", errorInfo.Error)
+	}
+	if errorInfo := tClientPtr.Drain(context.Background()); errorInfo.Error != nil {
+		t.Errorf("Drain after Close error = %v, want nil", errorInfo.Error)
+	}
+}
+
+// newFakeServerClient - starts a fake server and returns a client connected to it. Both are closed by the test cleanup.
+func newFakeServerClient(t *testing.T) (serverPtr *ai2ctest.Server, clientPtr *src.Ai2CClient) {
+
+	serverPtr, tErrorInfo := ai2ctest.NewServer()
+	if tErrorInfo.Error != nil {
+		t.Fatalf("NewServer error = %v", tErrorInfo.Error)
+	}
+	t.Cleanup(serverPtr.Close)
+
+	if clientPtr, tErrorInfo = serverPtr.NewClient(src.WithTimeout(5 * time.Second)); tErrorInfo.Error != nil {
+		t.Fatalf("NewClient error = %v", tErrorInfo.Error)
+	}
+	t.Cleanup(func() { clientPtr.Close() })
+
+	return serverPtr, clientPtr
+}
+
+// startBlockedRequest - sends a CancelPaymentIntent request that the fake server holds until release is closed. It
+// returns once the server has received the request.
+func startBlockedRequest(t *testing.T, serverPtr *ai2ctest.Server, clientPtr *src.Ai2CClient) (
+	release chan struct{},
+	result chan error,
+) {
+
+	release = make(chan struct{})
+	result = make(chan error, 1)
+
+	if errorInfo := serverPtr.Handle(ctv.SUB_STRIPE_CANCEL_PAYMENT_INTENT, func(request ai2ctest.Request) (interface{}, *src.ReplyError) {
+		<-release
+		return src.PaymentIntent{Id: "pi_1", Status: src.PAYMENT_INTENT_STATUS_CANCELED}, nil
+	}); errorInfo.Error != nil {
+		t.Fatalf("Handle error = %v", errorInfo.Error)
+	}
+
+	go func() {
+		_, errorInfo := clientPtr.CancelPaymentIntent(
+			context.Background(),
+			src.CancelPaymentIntentRequest{SaaSKey: "sk_test", PaymentIntentId: "pi_1", CancellationReason: "abandoned"},
+		)
+		result <- errorInfo.Error
+	}()
+
+	for tDeadline := time.Now().Add(5 * time.Second); len(serverPtr.Requests()) == 0; {
+		if time.Now().After(tDeadline) {
+			t.Fatal("the fake server did not receive the request")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	return
+}
+
+// waitForClosed - waits until the client refuses new requests with ErrClientClosed. The probe uses a subject without
+// a script, so the fake server answers it right away until then.
+func waitForClosed(t *testing.T, clientPtr *src.Ai2CClient) {
+
+	for tDeadline := time.Now().Add(5 * time.Second); ; {
+		_, errorInfo := clientPtr.ListPaymentMethods(context.Background(), src.ListPaymentMethodRequest{SaaSKey: "sk_test"})
+		if errors.Is(errorInfo.Error, src.ErrClientClosed) {
+			return
+		}
+		if time.Now().After(tDeadline) {
+			t.Fatalf("new request error = %v, want %v", errorInfo.Error, src.ErrClientClosed)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
@@ -0,0 +1,489 @@
+// Package src
+/*
+This is the Money type used for amounts sent to and received from the AI2C service.
+
+RESTRICTIONS:
+	None
+
+NOTES:
+    Money holds the amount as an int64 in the currency's minor unit, for example cents for USD, so amounts are exact.
+    The number of decimal places comes from the ISO 4217 exponent of the currency. Zero-decimal currencies, such as
+    JPY, have an exponent of 0. Three-decimal currencies, such as KWD, have an exponent of 3. All others have 2.
+
+    Currencies are stored in lower case, the way the AI2C service and Stripe expect them.
+
+    MoneyFromFloat is the conversion path for callers that still have float64 amounts. It rounds to the nearest
+    minor unit.
+
+COPYRIGHT:
+	Copyright 2022
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+
+*/
+package src
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	ctv "github.com/sty-holdings/constant-type-vars-go/v2024"
+	pi "github.com/sty-holdings/sty-shared/v2024/programInfo"
+)
+
+//goland:noinspection ALL
+const (
+	DEFAULT_CURRENCY_EXPONENT = 2
+)
+
+//goland:noinspection ALL
+const (
+	TXT_AMOUNT   = "Amount: "
+	TXT_CURRENCY = "Currency: "
+)
+
+var (
+	ErrAmountInvalid     = errors.New("the amount is not a valid decimal number for the currency")
+	ErrAmountNotPositive = errors.New("the amount must be greater than zero")
+	ErrAmountOverflow    = errors.New("the amount is too large")
+	ErrCurrencyInvalid   = errors.New("the currency must be a three letter ISO 4217 code")
+	ErrCurrencyMismatch  = errors.New("the amounts are in different currencies")
+)
+
+// currencyExponents - the ISO 4217 exponents that are not DEFAULT_CURRENCY_EXPONENT.
+var currencyExponents = map[string]int{
+	"bhd": 3,
+	"bif": 0,
+	"clp": 0,
+	"djf": 0,
+	"gnf": 0,
+	"iqd": 3,
+	"jod": 3,
+	"jpy": 0,
+	"kmf": 0,
+	"krw": 0,
+	"kwd": 3,
+	"lyd": 3,
+	"mga": 0,
+	"omr": 3,
+	"pyg": 0,
+	"rwf": 0,
+	"tnd": 3,
+	"ugx": 0,
+	"vnd": 0,
+	"vuv": 0,
+	"xaf": 0,
+	"xof": 0,
+	"xpf": 0,
+}
+
+// Money - an amount in the minor unit of the currency.
+type Money struct {
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+// CurrencyExponent - returns the number of decimal places used by the currency.
+//
+// Customer Messages: None
+// Errors: None
+// Verifications: None
+func CurrencyExponent(currency string) (exponent int) {
+
+	var (
+		tFound bool
+	)
+
+	if exponent, tFound = currencyExponents[strings.ToLower(currency)]; tFound == false {
+		exponent = DEFAULT_CURRENCY_EXPONENT
+	}
+
+	return
+}
+
+// MoneyFromFloat - converts an amount in the major unit, such as 123.34 dollars, to Money. The amount is rounded to
+// the nearest minor unit.
+//
+// Customer Messages: None
+// Errors: ErrAmountInvalid, ErrAmountOverflow, ErrCurrencyInvalid
+// Verifications: None
+func MoneyFromFloat(amount float64, currency string) (money Money, errorInfo pi.ErrorInfo) {
+
+	var (
+		tMinorUnits float64
+	)
+
+	if math.IsNaN(amount) || math.IsInf(amount, 0) {
+		errorInfo = pi.NewErrorInfo(ErrAmountInvalid, fmt.Sprintf("%v%v", TXT_AMOUNT, amount))
+		return
+	}
+
+	tMinorUnits = math.Round(amount * math.Pow10(CurrencyExponent(currency)))
+	if tMinorUnits >= math.MaxInt64 || tMinorUnits < math.MinInt64 {
+		errorInfo = pi.NewErrorInfo(ErrAmountOverflow, fmt.Sprintf("%v%v", TXT_AMOUNT, amount))
+		return
+	}
+
+	return NewMoney(int64(tMinorUnits), currency)
+}
+
+// NewMoney - returns Money for the amount in minor units, such as 12334 cents.
+//
+// Customer Messages: None
+// Errors: ErrCurrencyInvalid
+// Verifications: None
+func NewMoney(minorUnits int64, currency string) (money Money, errorInfo pi.ErrorInfo) {
+
+	if isCurrencyValid(currency) == false {
+		errorInfo = pi.NewErrorInfo(ErrCurrencyInvalid, fmt.Sprintf("%v%v", TXT_CURRENCY, currency))
+		return
+	}
+
+	return Money{Amount: minorUnits, Currency: strings.ToLower(currency)}, errorInfo
+}
+
+// ParseMoney - parses a decimal amount in the major unit, such as "123.34" dollars. The amount may have a leading
+// sign and may not have more decimal places than the currency uses.
+//
+// Customer Messages: None
+// Errors: ErrAmountInvalid, ErrAmountOverflow, ErrCurrencyInvalid
+// Verifications: None
+func ParseMoney(amount, currency string) (money Money, errorInfo pi.ErrorInfo) {
+
+	var (
+		tExponent   = CurrencyExponent(currency)
+		tFraction   string
+		tMinorUnits int64
+		tNegative   bool
+		tValue      = strings.TrimSpace(amount)
+		tWhole      string
+	)
+
+	if strings.HasPrefix(tValue, "-") || strings.HasPrefix(tValue, "+") {
+		tNegative = tValue[0] == '-'
+		tValue = tValue[1:]
+	}
+	tWhole, tFraction, _ = strings.Cut(tValue, ".")
+	if (tWhole == ctv.VAL_EMPTY && tFraction == ctv.VAL_EMPTY) || isDigits(tWhole) == false || isDigits(tFraction) == false ||
+		len(tFraction) > tExponent {
+		errorInfo = pi.NewErrorInfo(ErrAmountInvalid, fmt.Sprintf("%v%v %v", TXT_AMOUNT, amount, currency))
+		return
+	}
+
+	if tMinorUnits, errorInfo.Error = strconv.ParseInt(tWhole+tFraction+strings.Repeat("0", tExponent-len(tFraction)), 10, 64); errorInfo.Error != nil {
+		errorInfo = pi.NewErrorInfo(ErrAmountOverflow, fmt.Sprintf("%v%v %v", TXT_AMOUNT, amount, currency))
+		return
+	}
+	if tNegative {
+		tMinorUnits = -tMinorUnits
+	}
+
+	return NewMoney(tMinorUnits, currency)
+}
+
+// Add - returns the sum of the amounts.
+//
+// Customer Messages: None
+// Errors: ErrCurrencyMismatch, ErrAmountOverflow
+// Verifications: None
+func (money Money) Add(other Money) (sum Money, errorInfo pi.ErrorInfo) {
+
+	if errorInfo = money.checkCurrency(other); errorInfo.Error != nil {
+		return
+	}
+	if (other.Amount > 0 && money.Amount > math.MaxInt64-other.Amount) || (other.Amount < 0 && money.Amount < math.MinInt64-other.Amount) {
+		errorInfo = pi.NewErrorInfo(ErrAmountOverflow, fmt.Sprintf("%v%v + %v", TXT_AMOUNT, money, other))
+		return
+	}
+
+	return Money{Amount: money.Amount + other.Amount, Currency: money.Currency}, errorInfo
+}
+
+// Compare - returns -1, 0, or 1 when the amount is less than, equal to, or greater than other.
+//
+// Customer Messages: None
+// Errors: ErrCurrencyMismatch
+// Verifications: None
+func (money Money) Compare(other Money) (result int, errorInfo pi.ErrorInfo) {
+
+	if errorInfo = money.checkCurrency(other); errorInfo.Error != nil {
+		return
+	}
+
+	switch {
+	case money.Amount < other.Amount:
+		result = -1
+	case money.Amount > other.Amount:
+		result = 1
+	}
+
+	return
+}
+
+// Decimal - returns the amount in the major unit with the currency's decimal places, such as "123.34".
+//
+// Customer Messages: None
+// Errors: None
+// Verifications: None
+func (money Money) Decimal() (decimal string) {
+
+	var (
+		tDigits   string
+		tExponent = CurrencyExponent(money.Currency)
+		tSign     string
+	)
+
+	if money.Amount < 0 {
+		tSign = "-"
+		tDigits = strconv.FormatUint(uint64(-(money.Amount+1))+1, 10)
+	} else {
+		tDigits = strconv.FormatInt(money.Amount, 10)
+	}
+	if tExponent == 0 {
+		return tSign + tDigits
+	}
+	if len(tDigits) <= tExponent {
+		tDigits = strings.Repeat("0", tExponent-len(tDigits)+1) + tDigits
+	}
+
+	return fmt.Sprintf("%v%v.%v", tSign, tDigits[:len(tDigits)-tExponent], tDigits[len(tDigits)-tExponent:])
+}
+
+// Float64 - returns the amount in the major unit as a float64 for callers that still need one.
+//
+// Customer Messages: None
+// Errors: None
+// Verifications: None
+func (money Money) Float64() (amount float64) {
+
+	return float64(money.Amount) / math.Pow10(CurrencyExponent(money.Currency))
+}
+
+// IsNegative - returns true when the amount is less than zero.
+//
+// Customer Messages: None
+// Errors: None
+// Verifications: None
+func (money Money) IsNegative() bool {
+
+	return money.Amount < 0
+}
+
+// IsPositive - returns true when the amount is greater than zero.
+//
+// Customer Messages: None
+// Errors: None
+// Verifications: None
+func (money Money) IsPositive() bool {
+
+	return money.Amount > 0
+}
+
+// IsZero - returns true when the amount is zero.
+//
+// Customer Messages: None
+// Errors: None
+// Verifications: None
+func (money Money) IsZero() bool {
+
+	return money.Amount == 0
+}
+
+// MarshalJSON - encodes the money as {"amount": minor units, "currency": code}, which UnmarshalJSON decodes.
+//
+// Customer Messages: None
+// Errors: ErrCurrencyInvalid, json errors
+// Verifications: None
+func (money Money) MarshalJSON() (data []byte, err error) {
+
+	type tMoney Money
+
+	var (
+		tErrorInfo pi.ErrorInfo
+	)
+
+	if money, tErrorInfo = NewMoney(money.Amount, money.Currency); tErrorInfo.Error != nil {
+		return nil, tErrorInfo.Error
+	}
+
+	return json.Marshal(tMoney(money))
+}
+
+// Multiply - returns the amount multiplied by factor, such as the total for a quantity.
+//
+// Customer Messages: None
+// Errors: ErrAmountOverflow
+// Verifications: None
+func (money Money) Multiply(factor int64) (product Money, errorInfo pi.ErrorInfo) {
+
+	if money.Amount != 0 && factor != 0 {
+		if (money.Amount == -1 && factor == math.MinInt64) || (factor == -1 && money.Amount == math.MinInt64) ||
+			(money.Amount*factor)/factor != money.Amount {
+			errorInfo = pi.NewErrorInfo(ErrAmountOverflow, fmt.Sprintf("%v%v * %v", TXT_AMOUNT, money, factor))
+			return
+		}
+	}
+
+	return Money{Amount: money.Amount * factor, Currency: money.Currency}, errorInfo
+}
+
+// String - returns the decimal amount followed by the upper case currency, such as "123.34 USD".
+//
+// Customer Messages: None
+// Errors: None
+// Verifications: None
+func (money Money) String() string {
+
+	return fmt.Sprintf("%v %v", money.Decimal(), strings.ToUpper(money.Currency))
+}
+
+// Subtract - returns the amount less other.
+//
+// Customer Messages: None
+// Errors: ErrCurrencyMismatch, ErrAmountOverflow
+// Verifications: None
+func (money Money) Subtract(other Money) (difference Money, errorInfo pi.ErrorInfo) {
+
+	if errorInfo = money.checkCurrency(other); errorInfo.Error != nil {
+		return
+	}
+	if (other.Amount < 0 && money.Amount > math.MaxInt64+other.Amount) || (other.Amount > 0 && money.Amount < math.MinInt64+other.Amount) {
+		errorInfo = pi.NewErrorInfo(ErrAmountOverflow, fmt.Sprintf("%v%v - %v", TXT_AMOUNT, money, other))
+		return
+	}
+
+	return Money{Amount: money.Amount - other.Amount, Currency: money.Currency}, errorInfo
+}
+
+// UnmarshalJSON - decodes {"amount": minor units, "currency": code}, which MarshalJSON encodes, and checks the
+// currency.
+//
+// Customer Messages: None
+// Errors: ErrCurrencyInvalid, json errors
+// Verifications: None
+func (moneyPtr *Money) UnmarshalJSON(data []byte) (err error) {
+
+	type tMoney Money
+
+	var (
+		tErrorInfo pi.ErrorInfo
+		tValue     tMoney
+	)
+
+	if err = json.Unmarshal(data, &tValue); err != nil {
+		return
+	}
+	if *moneyPtr, tErrorInfo = NewMoney(tValue.Amount, tValue.Currency); tErrorInfo.Error != nil {
+		return tErrorInfo.Error
+	}
+
+	return
+}
+
+// Private Function below here
+
+// checkCurrency - returns ErrCurrencyMismatch when the amounts are in different currencies.
+//
+//	Customer Messages: None
+//	Errors: ErrCurrencyMismatch
+//	Verifications: None
+func (money Money) checkCurrency(other Money) (errorInfo pi.ErrorInfo) {
+
+	if strings.EqualFold(money.Currency, other.Currency) == false {
+		errorInfo = pi.NewErrorInfo(ErrCurrencyMismatch, fmt.Sprintf("%v%v, %v", TXT_CURRENCY, money.Currency, other.Currency))
+	}
+
+	return
+}
+
+// decimalAmount - returns the amount as a JSON number in the major unit and its currency. Both are empty when
+// amountPtr is nil, so the fields can be left out with omitempty.
+//
+//	Customer Messages: None
+//	Errors: None
+//	Verifications: None
+func decimalAmount(amountPtr *Money) (amount json.Number, currency string) {
+
+	if amountPtr == nil {
+		return
+	}
+
+	return json.Number(amountPtr.Decimal()), amountPtr.Currency
+}
+
+// isCurrencyValid - returns true when the currency is three letters.
+//
+//	Customer Messages: None
+//	Errors: None
+//	Verifications: None
+func isCurrencyValid(currency string) bool {
+
+	if len(currency) != 3 {
+		return false
+	}
+	for _, character := range strings.ToLower(currency) {
+		if character < 'a' || character > 'z' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isDigits - returns true when value only holds the digits 0 through 9. An empty value is allowed.
+//
+//	Customer Messages: None
+//	Errors: None
+//	Verifications: None
+func isDigits(value string) bool {
+
+	for _, character := range value {
+		if character < '0' || character > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// replyMoney - returns the minor units and currency code of a reply as Money. Replies carry the code as AI2C
+// returned it, so it is lower cased the same way NewMoney does.
+//
+//	Customer Messages: None
+//	Errors: None
+//	Verifications: None
+func replyMoney(minorUnits int64, currency string) (money Money) {
+
+	return Money{Amount: minorUnits, Currency: strings.ToLower(currency)}
+}
+
+// validateAmount - returns an error unless the amount is greater than zero and the currency is valid.
+//
+//	Customer Messages: None
+//	Errors: ErrAmountNotPositive, ErrCurrencyInvalid
+//	Verifications: None
+func validateAmount(amount Money) (errorInfo pi.ErrorInfo) {
+
+	if amount.IsPositive() == false {
+		errorInfo = pi.NewErrorInfo(ErrAmountNotPositive, fmt.Sprintf("%v%v", TXT_AMOUNT, amount))
+		return
+	}
+	if isCurrencyValid(amount.Currency) == false {
+		errorInfo = pi.NewErrorInfo(ErrCurrencyInvalid, fmt.Sprintf("%v%v", TXT_CURRENCY, amount.Currency))
+	}
+
+	return
+}
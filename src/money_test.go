@@ -0,0 +1,272 @@
+package src
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestParseMoney(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		amount   string
+		currency string
+		want     Money
+		wantErr  error
+	}{
+		{name: "whole and fraction", amount: "123.34", currency: "usd", want: Money{Amount: 12334, Currency: "usd"}},
+		{name: "upper case currency", amount: "123.34", currency: "USD", want: Money{Amount: 12334, Currency: "usd"}},
+		{name: "short fraction", amount: "1.5", currency: "usd", want: Money{Amount: 150, Currency: "usd"}},
+		{name: "no whole part", amount: ".05", currency: "usd", want: Money{Amount: 5, Currency: "usd"}},
+		{name: "no fraction", amount: "7", currency: "usd", want: Money{Amount: 700, Currency: "usd"}},
+		{name: "negative", amount: "-0.01", currency: "usd", want: Money{Amount: -1, Currency: "usd"}},
+		{name: "plus sign and spaces", amount: " +2.00 ", currency: "usd", want: Money{Amount: 200, Currency: "usd"}},
+		{name: "zero exponent", amount: "500", currency: "jpy", want: Money{Amount: 500, Currency: "jpy"}},
+		{name: "three exponent", amount: "1.234", currency: "kwd", want: Money{Amount: 1234, Currency: "kwd"}},
+		{name: "fraction on zero exponent", amount: "500.1", currency: "jpy", wantErr: ErrAmountInvalid},
+		{name: "too many decimal places", amount: "1.234", currency: "usd", wantErr: ErrAmountInvalid},
+		{name: "empty", amount: "", currency: "usd", wantErr: ErrAmountInvalid},
+		{name: "only a point", amount: ".", currency: "usd", wantErr: ErrAmountInvalid},
+		{name: "not a number", amount: "1e5", currency: "usd", wantErr: ErrAmountInvalid},
+		{name: "too large", amount: "92233720368547758.08", currency: "usd", wantErr: ErrAmountOverflow},
+		{name: "invalid currency", amount: "1.00", currency: "us", wantErr: ErrCurrencyInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, errorInfo := ParseMoney(tt.amount, tt.currency)
+			if errors.Is(errorInfo.Error, tt.wantErr) == false {
+				t.Fatalf("ParseMoney(%q, %q) error = %v, want %v", tt.amount, tt.currency, errorInfo.Error, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseMoney(%q, %q) = %+v, want %+v", tt.amount, tt.currency, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMoneyFromFloat(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		amount   float64
+		currency string
+		want     Money
+		wantErr  error
+	}{
+		{name: "exact", amount: 123.34, currency: "usd", want: Money{Amount: 12334, Currency: "usd"}},
+		{name: "binary fraction rounds to nearest", amount: 0.29, currency: "usd", want: Money{Amount: 29, Currency: "usd"}},
+		{name: "just below a half cent", amount: 1.005, currency: "usd", want: Money{Amount: 100, Currency: "usd"}},
+		{name: "half cent up", amount: 0.125, currency: "usd", want: Money{Amount: 13, Currency: "usd"}},
+		{name: "negative half cent", amount: -0.125, currency: "usd", want: Money{Amount: -13, Currency: "usd"}},
+		{name: "zero exponent rounds to whole", amount: 499.5, currency: "jpy", want: Money{Amount: 500, Currency: "jpy"}},
+		{name: "three exponent", amount: 1.2346, currency: "bhd", want: Money{Amount: 1235, Currency: "bhd"}},
+		{name: "zero", amount: 0, currency: "eur", want: Money{Amount: 0, Currency: "eur"}},
+		{name: "not a number", amount: math.NaN(), currency: "usd", wantErr: ErrAmountInvalid},
+		{name: "infinite", amount: math.Inf(1), currency: "usd", wantErr: ErrAmountInvalid},
+		{name: "too large", amount: 1e17, currency: "usd", wantErr: ErrAmountOverflow},
+		{name: "invalid currency", amount: 1, currency: "dollars", wantErr: ErrCurrencyInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, errorInfo := MoneyFromFloat(tt.amount, tt.currency)
+			if errors.Is(errorInfo.Error, tt.wantErr) == false {
+				t.Fatalf("MoneyFromFloat(%v, %q) error = %v, want %v", tt.amount, tt.currency, errorInfo.Error, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("MoneyFromFloat(%v, %q) = %+v, want %+v", tt.amount, tt.currency, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMoneyDecimal(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		money Money
+		want  string
+	}{
+		{name: "two exponent", money: Money{Amount: 12334, Currency: "usd"}, want: "123.34"},
+		{name: "less than one", money: Money{Amount: 5, Currency: "usd"}, want: "0.05"},
+		{name: "zero", money: Money{Amount: 0, Currency: "usd"}, want: "0.00"},
+		{name: "negative", money: Money{Amount: -150, Currency: "eur"}, want: "-1.50"},
+		{name: "upper case currency", money: Money{Amount: 100, Currency: "JPY"}, want: "100"},
+		{name: "zero exponent", money: Money{Amount: 500, Currency: "jpy"}, want: "500"},
+		{name: "negative zero exponent", money: Money{Amount: -7, Currency: "krw"}, want: "-7"},
+		{name: "three exponent", money: Money{Amount: 1, Currency: "kwd"}, want: "0.001"},
+		{name: "smallest int64", money: Money{Amount: math.MinInt64, Currency: "usd"}, want: "-92233720368547758.08"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.money.Decimal(); got != tt.want {
+				t.Errorf("%+v.Decimal() = %q, want %q", tt.money, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMoneyJSON(t *testing.T) {
+
+	var (
+		tDecoded Money
+		tMoney   = Money{Amount: 12334, Currency: "USD"}
+	)
+
+	tData, err := json.Marshal(tMoney)
+	if err != nil {
+		t.Fatalf("json.Marshal(%+v) error = %v", tMoney, err)
+	}
+	if string(tData) != `{"amount":12334,"currency":"usd"}` {
+		t.Errorf("json.Marshal(%+v) = %s", tMoney, tData)
+	}
+	if err = json.Unmarshal(tData, &tDecoded); err != nil {
+		t.Fatalf("json.Unmarshal(%s) error = %v", tData, err)
+	}
+	if tDecoded != (Money{Amount: 12334, Currency: "usd"}) {
+		t.Errorf("json.Unmarshal(%s) = %+v", tData, tDecoded)
+	}
+
+	if _, err = json.Marshal(Money{Amount: 1}); errors.Is(err, ErrCurrencyInvalid) == false {
+		t.Errorf("json.Marshal without a currency error = %v, want %v", err, ErrCurrencyInvalid)
+	}
+}
+
+func TestMoneyAddSubtract(t *testing.T) {
+
+	tests := []struct {
+		name           string
+		money          Money
+		other          Money
+		wantSum        Money
+		wantSumErr     error
+		wantDifference Money
+		wantDiffErr    error
+	}{
+		{
+			name:           "same currency",
+			money:          Money{Amount: 1000, Currency: "usd"},
+			other:          Money{Amount: 250, Currency: "USD"},
+			wantSum:        Money{Amount: 1250, Currency: "usd"},
+			wantDifference: Money{Amount: 750, Currency: "usd"},
+		},
+		{
+			name:        "currency mismatch",
+			money:       Money{Amount: 1000, Currency: "usd"},
+			other:       Money{Amount: 250, Currency: "eur"},
+			wantSumErr:  ErrCurrencyMismatch,
+			wantDiffErr: ErrCurrencyMismatch,
+		},
+		{
+			name:           "max plus one",
+			money:          Money{Amount: math.MaxInt64, Currency: "usd"},
+			other:          Money{Amount: 1, Currency: "usd"},
+			wantSumErr:     ErrAmountOverflow,
+			wantDifference: Money{Amount: math.MaxInt64 - 1, Currency: "usd"},
+		},
+		{
+			name:        "max minus negative one",
+			money:       Money{Amount: math.MaxInt64, Currency: "usd"},
+			other:       Money{Amount: -1, Currency: "usd"},
+			wantSum:     Money{Amount: math.MaxInt64 - 1, Currency: "usd"},
+			wantDiffErr: ErrAmountOverflow,
+		},
+		{
+			name:        "min minus one",
+			money:       Money{Amount: math.MinInt64, Currency: "usd"},
+			other:       Money{Amount: 1, Currency: "usd"},
+			wantSum:     Money{Amount: math.MinInt64 + 1, Currency: "usd"},
+			wantDiffErr: ErrAmountOverflow,
+		},
+		{
+			name:           "min plus negative one",
+			money:          Money{Amount: math.MinInt64, Currency: "usd"},
+			other:          Money{Amount: -1, Currency: "usd"},
+			wantSumErr:     ErrAmountOverflow,
+			wantDifference: Money{Amount: math.MinInt64 + 1, Currency: "usd"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tSum, errorInfo := tt.money.Add(tt.other)
+			if errors.Is(errorInfo.Error, tt.wantSumErr) == false {
+				t.Errorf("%+v.Add(%+v) error = %v, want %v", tt.money, tt.other, errorInfo.Error, tt.wantSumErr)
+			}
+			if tSum != tt.wantSum {
+				t.Errorf("%+v.Add(%+v) = %+v, want %+v", tt.money, tt.other, tSum, tt.wantSum)
+			}
+			tDifference, errorInfo := tt.money.Subtract(tt.other)
+			if errors.Is(errorInfo.Error, tt.wantDiffErr) == false {
+				t.Errorf("%+v.Subtract(%+v) error = %v, want %v", tt.money, tt.other, errorInfo.Error, tt.wantDiffErr)
+			}
+			if tDifference != tt.wantDifference {
+				t.Errorf("%+v.Subtract(%+v) = %+v, want %+v", tt.money, tt.other, tDifference, tt.wantDifference)
+			}
+		})
+	}
+}
+
+func TestMoneyMultiply(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		money   Money
+		factor  int64
+		want    Money
+		wantErr error
+	}{
+		{name: "positive", money: Money{Amount: 1999, Currency: "usd"}, factor: 3, want: Money{Amount: 5997, Currency: "usd"}},
+		{name: "negative", money: Money{Amount: 1999, Currency: "usd"}, factor: -2, want: Money{Amount: -3998, Currency: "usd"}},
+		{name: "zero factor", money: Money{Amount: math.MaxInt64, Currency: "usd"}, factor: 0, want: Money{Currency: "usd"}},
+		{name: "max times one", money: Money{Amount: math.MaxInt64, Currency: "usd"}, factor: 1, want: Money{Amount: math.MaxInt64, Currency: "usd"}},
+		{name: "max times two", money: Money{Amount: math.MaxInt64, Currency: "usd"}, factor: 2, wantErr: ErrAmountOverflow},
+		{name: "min times negative one", money: Money{Amount: math.MinInt64, Currency: "usd"}, factor: -1, wantErr: ErrAmountOverflow},
+		{name: "negative one times min", money: Money{Amount: -1, Currency: "usd"}, factor: math.MinInt64, wantErr: ErrAmountOverflow},
+		{name: "large factor", money: Money{Amount: 1 << 32, Currency: "usd"}, factor: 1 << 32, wantErr: ErrAmountOverflow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, errorInfo := tt.money.Multiply(tt.factor)
+			if errors.Is(errorInfo.Error, tt.wantErr) == false {
+				t.Fatalf("%+v.Multiply(%v) error = %v, want %v", tt.money, tt.factor, errorInfo.Error, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("%+v.Multiply(%v) = %+v, want %+v", tt.money, tt.factor, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMoneyCompare(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		money   Money
+		other   Money
+		want    int
+		wantErr error
+	}{
+		{name: "less", money: Money{Amount: math.MinInt64, Currency: "usd"}, other: Money{Amount: math.MaxInt64, Currency: "usd"}, want: -1},
+		{name: "equal", money: Money{Amount: 500, Currency: "usd"}, other: Money{Amount: 500, Currency: "USD"}, want: 0},
+		{name: "greater", money: Money{Amount: math.MaxInt64, Currency: "usd"}, other: Money{Amount: math.MinInt64, Currency: "usd"}, want: 1},
+		{name: "currency mismatch", money: Money{Amount: 500, Currency: "usd"}, other: Money{Amount: 500, Currency: "eur"}, wantErr: ErrCurrencyMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, errorInfo := tt.money.Compare(tt.other)
+			if errors.Is(errorInfo.Error, tt.wantErr) == false {
+				t.Fatalf("%+v.Compare(%+v) error = %v, want %v", tt.money, tt.other, errorInfo.Error, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("%+v.Compare(%+v) = %v, want %v", tt.money, tt.other, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,194 @@
+// Package src
+/*
+These are the options used by New to build an Ai2CClient.
+
+RESTRICTIONS:
+	None
+
+NOTES:
+    Values set with an option take precedence over the values in the configuration file.
+
+COPYRIGHT:
+	Copyright 2022
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+
+*/
+package src
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	ctv "github.com/sty-holdings/constant-type-vars-go/v2024"
+	cfgs "github.com/sty-holdings/sty-shared/v2024/configuration"
+	pi "github.com/sty-holdings/sty-shared/v2024/programInfo"
+)
+
+// Logger - receives the client's log output. *log.Logger satisfies this interface.
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
+// Option - sets a value used by New.
+type Option func(configPtr *clientConfig)
+
+type clientConfig struct {
+	configFileFQN       string
+	environment         string
+	inMemoryCredentials bool
+	logger              Logger
+	natsConnPtr         *nats.Conn
+	natsOptions         []nats.Option
+	parameterSource     ParameterSource
+	password            string
+	requestTimeout      time.Duration
+	secretKey           string
+	styhClientId        string
+	tempDirectory       string
+	tokenHooks          TokenHooks
+	tokenRefreshMargin  time.Duration
+	username            string
+}
+
+// WithConfigFile - reads the settings from the configuration file. Values set with other options are not replaced.
+func WithConfigFile(configFileFQN string) Option {
+	return func(configPtr *clientConfig) {
+		configPtr.configFileFQN = configFileFQN
+	}
+}
+
+// WithCredentials - sets the AI2 Connect assigned client id and secret key, and the username and password
+// selected when signing up for AI2 Connect services.
+func WithCredentials(styhClientId, username, password, secretKey string) Option {
+	return func(configPtr *clientConfig) {
+		configPtr.styhClientId = styhClientId
+		configPtr.username = username
+		configPtr.password = password
+		configPtr.secretKey = secretKey
+	}
+}
+
+// WithEnvironment - sets the environment, either development or production. It is required and has no default.
+func WithEnvironment(environment string) Option {
+	return func(configPtr *clientConfig) {
+		configPtr.environment = environment
+	}
+}
+
+// WithLogger - sends the client's log output to logger instead of the default output.
+func WithLogger(logger Logger) Option {
+	return func(configPtr *clientConfig) {
+		configPtr.logger = logger
+	}
+}
+
+// WithNATSConnection - uses connPtr instead of connecting to the AI2C NATS service. AWS Cognito, the parameter
+// source, and the temporary directory are not used. Close and Drain close connPtr. This is meant for tests against a
+// local NATS server, such as the one started by the ai2ctest package.
+func WithNATSConnection(connPtr *nats.Conn) Option {
+	return func(configPtr *clientConfig) {
+		configPtr.natsConnPtr = connPtr
+	}
+}
+
+// WithNATSOptions - adds options used when connecting to the NATS service. They are applied after the client's
+// own options, so they can override them.
+func WithNATSOptions(natsOptions ...nats.Option) Option {
+	return func(configPtr *clientConfig) {
+		configPtr.natsOptions = append(configPtr.natsOptions, natsOptions...)
+	}
+}
+
+// WithTempDirectory - sets the directory where the client writes the NATS credentials and TLS files. When no
+// temporary directory is set, they are kept in memory.
+func WithTempDirectory(tempDirectory string) Option {
+	return func(configPtr *clientConfig) {
+		configPtr.tempDirectory = tempDirectory
+	}
+}
+
+// WithTimeout - sets how long a request waits for a reply when the caller's context has no deadline.
+func WithTimeout(requestTimeout time.Duration) Option {
+	return func(configPtr *clientConfig) {
+		configPtr.requestTimeout = requestTimeout
+	}
+}
+
+// Private Function below here
+
+// getConfigString - returns the string value for the key or empty when it is missing or not a string.
+//
+//	Customer Messages: None
+//	Errors: None
+//	Verifications: None
+func getConfigString(configMap map[string]interface{}, key string) (value string) {
+
+	value, _ = configMap[key].(string)
+
+	return
+}
+
+// loadConfigFile - fills the settings that have not been set with an option from the configuration file. When
+// request_timeout_seconds is present, it must be a positive number.
+//
+//	Customer Messages: None
+//	Errors: ErrTimeoutInvalid, Errors returned by cfgs.GetConfigFile
+//	Verifications: None
+func (configPtr *clientConfig) loadConfigFile() (errorInfo pi.ErrorInfo) {
+
+	var (
+		tConfigMap      map[string]interface{}
+		tFound          bool
+		tRequestTimeout float64
+		tValue          interface{}
+	)
+
+	if tConfigMap, errorInfo = cfgs.GetConfigFile(configPtr.configFileFQN); errorInfo.Error != nil {
+		return
+	}
+
+	if configPtr.styhClientId == ctv.VAL_EMPTY {
+		configPtr.styhClientId = getConfigString(tConfigMap, ctv.FN_STYH_CLIENT_ID)
+	}
+	if configPtr.environment == ctv.VAL_EMPTY {
+		configPtr.environment = getConfigString(tConfigMap, ctv.FN_ENVIRONMENT)
+	}
+	if configPtr.password == ctv.VAL_EMPTY {
+		configPtr.password = getConfigString(tConfigMap, ctv.FN_PASSWORD)
+	}
+	tConfigMap[ctv.FN_PASSWORD] = ctv.TXT_PROTECTED // Clear the password from memory.
+	if configPtr.secretKey == ctv.VAL_EMPTY {
+		configPtr.secretKey = getConfigString(tConfigMap, ctv.FN_SECRET_KEY)
+	}
+	tConfigMap[ctv.FN_SECRET_KEY] = ctv.TXT_PROTECTED // Clear the secret key from memory.
+	if configPtr.tempDirectory == ctv.VAL_EMPTY {
+		configPtr.tempDirectory = getConfigString(tConfigMap, ctv.FN_TEMP_DIRECTORY)
+	}
+	if configPtr.username == ctv.VAL_EMPTY {
+		configPtr.username = getConfigString(tConfigMap, ctv.FN_USERNAME)
+	}
+	// request_timeout_seconds is optional
+	if tValue, tFound = tConfigMap[FN_REQUEST_TIMEOUT]; tFound == false {
+		return
+	}
+	if tRequestTimeout, _ = tValue.(float64); tRequestTimeout <= 0 {
+		errorInfo = pi.NewErrorInfo(ErrTimeoutInvalid, fmt.Sprintf("%v%v=%v", TXT_REQUEST_TIMEOUT, FN_REQUEST_TIMEOUT, tValue))
+		return
+	}
+	if configPtr.requestTimeout == 0 {
+		configPtr.requestTimeout = time.Duration(tRequestTimeout * float64(time.Second))
+	}
+
+	return
+}
@@ -0,0 +1,313 @@
+// Package src
+/*
+These are the sources the Ai2CClient reads the NATS and TLS parameters from.
+
+RESTRICTIONS:
+	None
+
+NOTES:
+    AWS SSM is used unless another source is set with WithParameterSource. When another source is used, the client
+    does not log into AWS Cognito, so it can run against a local NATS server without AWS.
+
+    Parameters are requested by their short name, for example ctv.PARAMETER_NATS_URL. The file source expects the
+    short names as keys. The environment source upper cases the short name, replaces everything other than letters
+    and digits with an underscore, and adds the prefix. With the prefix AI2C, nats-url is read from AI2C_NATS_URL.
+
+COPYRIGHT:
+	Copyright 2022
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+
+*/
+package src
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	awsSSM "github.com/aws/aws-sdk-go-v2/service/ssm"
+	ctv "github.com/sty-holdings/constant-type-vars-go/v2024"
+	awss "github.com/sty-holdings/sty-shared/v2024/awsServices"
+	ns "github.com/sty-holdings/sty-shared/v2024/natsSerices"
+	pi "github.com/sty-holdings/sty-shared/v2024/programInfo"
+	"gopkg.in/yaml.v3"
+)
+
+//goland:noinspection ALL
+const (
+	DEFAULT_ENVIRONMENT_PREFIX = "AI2C"
+)
+
+//goland:noinspection ALL
+const (
+	TXT_PARAMETER      = "Parameter: "
+	TXT_PARAMETER_FILE = "Parameter file: "
+)
+
+var (
+	ErrParameterFileType = errors.New("the parameter file must end in .json, .yaml, or .yml")
+	ErrParameterInvalid  = errors.New("the parameter value is invalid")
+	ErrParameterMissing  = errors.New("required parameters are missing")
+)
+
+// ParameterSource - returns the values for the short parameter names. Names without a value are left out of the map.
+type ParameterSource interface {
+	GetParameters(ctx context.Context, environment string, names ...string) (parameters map[string]string, errorInfo pi.ErrorInfo)
+}
+
+// EnvironmentParameterSource - reads parameters from environment variables.
+type EnvironmentParameterSource struct {
+	Prefix string
+}
+
+// FileParameterSource - reads parameters from a JSON or YAML file holding short name and value pairs.
+type FileParameterSource struct {
+	FileFQN string
+}
+
+type ssmParameterSource struct {
+	awsSettings     awss.AWSSettings
+	tokenManagerPtr *tokenManager
+}
+
+// NewEnvironmentParameterSource - returns a source that reads parameters from environment variables starting with
+// prefix. When prefix is empty, DEFAULT_ENVIRONMENT_PREFIX is used.
+//
+// Customer Messages: None
+// Errors: None
+// Verifications: None
+func NewEnvironmentParameterSource(prefix string) (source EnvironmentParameterSource) {
+
+	if prefix == ctv.VAL_EMPTY {
+		prefix = DEFAULT_ENVIRONMENT_PREFIX
+	}
+
+	return EnvironmentParameterSource{Prefix: prefix}
+}
+
+// NewFileParameterSource - returns a source that reads parameters from a .json, .yaml, or .yml file.
+//
+// Customer Messages: None
+// Errors: None
+// Verifications: None
+func NewFileParameterSource(fileFQN string) (source FileParameterSource) {
+
+	return FileParameterSource{FileFQN: fileFQN}
+}
+
+// WithParameterSource - reads the NATS and TLS parameters from source instead of AWS SSM. AWS Cognito is not used.
+func WithParameterSource(source ParameterSource) Option {
+	return func(configPtr *clientConfig) {
+		configPtr.parameterSource = source
+	}
+}
+
+// GetParameters - reads each name from the environment variable built from the prefix and the name.
+//
+// Customer Messages: None
+// Errors: None
+// Verifications: None
+func (source EnvironmentParameterSource) GetParameters(ctx context.Context, environment string, names ...string) (
+	parameters map[string]string,
+	errorInfo pi.ErrorInfo,
+) {
+
+	var (
+		tValue string
+		tFound bool
+	)
+
+	parameters = make(map[string]string)
+	for _, name := range names {
+		if tValue, tFound = os.LookupEnv(environmentVariableName(source.Prefix, name)); tFound {
+			parameters[name] = tValue
+		}
+	}
+
+	return
+}
+
+// GetParameters - reads the file and returns the values for the names. Numbers and booleans are returned as text.
+//
+// Customer Messages: None
+// Errors: ErrParameterFileType, os errors, json and yaml errors
+// Verifications: None
+func (source FileParameterSource) GetParameters(ctx context.Context, environment string, names ...string) (
+	parameters map[string]string,
+	errorInfo pi.ErrorInfo,
+) {
+
+	var (
+		tContents []byte
+		tValues   = make(map[string]interface{})
+	)
+
+	if tContents, errorInfo.Error = os.ReadFile(source.FileFQN); errorInfo.Error != nil {
+		errorInfo = pi.NewErrorInfo(errorInfo.Error, fmt.Sprintf("%v%v", TXT_PARAMETER_FILE, source.FileFQN))
+		return
+	}
+
+	switch strings.ToLower(filepath.Ext(source.FileFQN)) {
+	case ".json":
+		errorInfo.Error = json.Unmarshal(tContents, &tValues)
+	case ".yaml", ".yml":
+		errorInfo.Error = yaml.Unmarshal(tContents, &tValues)
+	default:
+		errorInfo.Error = ErrParameterFileType
+	}
+	if errorInfo.Error != nil {
+		errorInfo = pi.NewErrorInfo(errorInfo.Error, fmt.Sprintf("%v%v", TXT_PARAMETER_FILE, source.FileFQN))
+		return
+	}
+
+	parameters = make(map[string]string)
+	for _, name := range names {
+		if tValue, ok := tValues[name]; ok && tValue != nil {
+			parameters[name] = fmt.Sprintf("%v", tValue)
+		}
+	}
+
+	return
+}
+
+// GetParameters - reads the parameters from AWS SSM using the client's Cognito ID token.
+//
+// Customer Messages: None
+// Errors: Errors returned by awss.GetParameters and the token manager
+// Verifications: None
+func (source ssmParameterSource) GetParameters(ctx context.Context, environment string, names ...string) (
+	parameters map[string]string,
+	errorInfo pi.ErrorInfo,
+) {
+
+	var (
+		tIDToken          string
+		tNames            = make(map[string]string)
+		tParameterNames   []string
+		tParametersOutput awsSSM.GetParametersOutput
+	)
+
+	if tIDToken, errorInfo = source.tokenManagerPtr.getIDToken(ctx); errorInfo.Error != nil {
+		return
+	}
+
+	for _, name := range names {
+		tNames[ctv.GetParameterName(AI2C_SSM_PARAMETER_PREFIX, environment, name)] = name
+		tParameterNames = append(tParameterNames, ctv.GetParameterName(AI2C_SSM_PARAMETER_PREFIX, environment, name))
+	}
+
+	if tParametersOutput, errorInfo = awss.GetParameters(
+		source.awsSettings.STYHCognitoIdentityInfo,
+		source.awsSettings.BaseConfig,
+		tIDToken,
+		tParameterNames...,
+	); errorInfo.Error != nil {
+		return
+	}
+
+	parameters = make(map[string]string)
+	for _, parameter := range tParametersOutput.Parameters {
+		if parameter.Name == nil || parameter.Value == nil {
+			continue
+		}
+		// Names that were not requested are ignored.
+		if tName, ok := tNames[*parameter.Name]; ok {
+			parameters[tName] = *parameter.Value
+		}
+	}
+
+	return
+}
+
+// Private Function below here
+
+// environmentVariableName - builds the environment variable name from the prefix and the short parameter name.
+//
+//	Customer Messages: None
+//	Errors: None
+//	Verifications: None
+func environmentVariableName(prefix, name string) (variableName string) {
+
+	var (
+		tBuilder strings.Builder
+	)
+
+	for _, character := range strings.ToUpper(prefix + "_" + name) {
+		if (character >= 'A' && character <= 'Z') || (character >= '0' && character <= '9') {
+			tBuilder.WriteRune(character)
+		} else {
+			tBuilder.WriteRune('_')
+		}
+	}
+
+	return tBuilder.String()
+}
+
+// processClientParameters - gets the NATS and TLS parameters from the source and stores them in natsConfigPtr.
+// Every parameter is required and the port must be a number between 1 and 65535.
+//
+//	Customer Messages: None
+//	Errors: ErrParameterMissing, ErrParameterInvalid, Errors returned by the source
+//	Verifications: None
+func processClientParameters(
+	ctx context.Context,
+	source ParameterSource,
+	environment string,
+	natsConfigPtr *ns.NATSConfiguration,
+) (errorInfo pi.ErrorInfo) {
+
+	var (
+		tMissing []string
+		tNames   = []string{
+			ctv.PARAMETER_NATS_TOKEN,
+			ctv.PARAMETER_NATS_PORT,
+			ctv.PARAMETER_NATS_URL,
+			ctv.PARAMETER_TLS_CERT,
+			ctv.PARAMETER_TLS_PRIVATE_KEY,
+			ctv.PARAMETER_TLS_CA_BUNDLE,
+		}
+		tParameters map[string]string
+	)
+
+	if tParameters, errorInfo = source.GetParameters(ctx, environment, tNames...); errorInfo.Error != nil {
+		return
+	}
+
+	for _, name := range tNames {
+		if strings.TrimSpace(tParameters[name]) == ctv.VAL_EMPTY {
+			tMissing = append(tMissing, name)
+		}
+	}
+	if len(tMissing) > ctv.VAL_ZERO {
+		errorInfo = pi.NewErrorInfo(ErrParameterMissing, fmt.Sprintf("%v%v", TXT_PARAMETER, strings.Join(tMissing, ", ")))
+		return
+	}
+
+	if natsConfigPtr.NATSPort, errorInfo.Error = strconv.Atoi(strings.TrimSpace(tParameters[ctv.PARAMETER_NATS_PORT])); errorInfo.Error != nil ||
+		natsConfigPtr.NATSPort < 1 || natsConfigPtr.NATSPort > 65535 {
+		errorInfo = pi.NewErrorInfo(ErrParameterInvalid, fmt.Sprintf("%v%v=%v", TXT_PARAMETER, ctv.PARAMETER_NATS_PORT, tParameters[ctv.PARAMETER_NATS_PORT]))
+		return
+	}
+	natsConfigPtr.NATSToken = tParameters[ctv.PARAMETER_NATS_TOKEN]
+	natsConfigPtr.NATSURL = tParameters[ctv.PARAMETER_NATS_URL]
+	natsConfigPtr.NATSTLSInfo.TLSCert = tParameters[ctv.PARAMETER_TLS_CERT]
+	natsConfigPtr.NATSTLSInfo.TLSPrivateKey = tParameters[ctv.PARAMETER_TLS_PRIVATE_KEY]
+	natsConfigPtr.NATSTLSInfo.TLSCABundle = tParameters[ctv.PARAMETER_TLS_CA_BUNDLE]
+
+	return
+}
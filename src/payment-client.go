@@ -0,0 +1,118 @@
+// Package src
+/*
+This is the interface for the payment operations, so code using an Ai2CClient can substitute a fake.
+
+RESTRICTIONS:
+	None
+
+NOTES:
+    *Ai2CClient satisfies PaymentClient. The ai2cmock package has a mock that records calls and returns programmed
+    replies. Every payment operation added to Ai2CClient must be added to PaymentClient and the mock.
+
+COPYRIGHT:
+	Copyright 2022
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+
+*/
+package src
+
+import (
+	"context"
+
+	pi "github.com/sty-holdings/sty-shared/v2024/programInfo"
+)
+
+// PaymentClient - the payment operations of an Ai2CClient.
+type PaymentClient interface {
+	AI2PaymentRequest(ai2CPaymentInfo Ai2CPaymentInfo) (reply []byte, errorInfo pi.ErrorInfo)
+	ArchivePrice(ctx context.Context, request ArchivePriceRequest) (price Price, errorInfo pi.ErrorInfo)
+	ArchiveProduct(ctx context.Context, request ArchiveProductRequest) (product Product, errorInfo pi.ErrorInfo)
+	AttachPaymentMethod(ctx context.Context, request AttachPaymentMethodRequest) (paymentMethod PaymentMethod, errorInfo pi.ErrorInfo)
+	CancelPaymentIntent(ctx context.Context, request CancelPaymentIntentRequest) (cancelResult CancelResult, errorInfo pi.ErrorInfo)
+	CancelRefund(ctx context.Context, request CancelRefundRequest) (refund Refund, errorInfo pi.ErrorInfo)
+	CancelSetupIntent(ctx context.Context, request CancelSetupIntentRequest) (setupIntent SetupIntent, errorInfo pi.ErrorInfo)
+	CancelSubscription(ctx context.Context, request CancelSubscriptionRequest) (subscription Subscription, errorInfo pi.ErrorInfo)
+	CapturePaymentIntent(ctx context.Context, request CapturePaymentIntentRequest) (paymentIntent PaymentIntent, errorInfo pi.ErrorInfo)
+	CloseDispute(ctx context.Context, request CloseDisputeRequest) (dispute Dispute, errorInfo pi.ErrorInfo)
+	ConfirmPaymentIntent(ctx context.Context, request ConfirmPaymentIntentRequest) (paymentIntent PaymentIntent, errorInfo pi.ErrorInfo)
+	ConfirmSetupIntent(ctx context.Context, request ConfirmSetupIntentRequest) (setupIntent SetupIntent, errorInfo pi.ErrorInfo)
+	CreateCheckoutSession(ctx context.Context, request CreateCheckoutSessionRequest) (checkoutSession CheckoutSession, errorInfo pi.ErrorInfo)
+	CreateCoupon(ctx context.Context, request CreateCouponRequest) (coupon Coupon, errorInfo pi.ErrorInfo)
+	CreateCustomer(ctx context.Context, request CreateCustomerRequest) (customer Customer, errorInfo pi.ErrorInfo)
+	CreateInvoice(ctx context.Context, request CreateInvoiceRequest) (invoice Invoice, errorInfo pi.ErrorInfo)
+	CreateInvoiceItem(ctx context.Context, request CreateInvoiceItemRequest) (invoiceItem InvoiceItem, errorInfo pi.ErrorInfo)
+	CreatePaymentIntent(ctx context.Context, request PaymentIntentRequest) (paymentIntent PaymentIntent, errorInfo pi.ErrorInfo)
+	CreatePaymentLink(ctx context.Context, request CreatePaymentLinkRequest) (paymentLink PaymentLink, errorInfo pi.ErrorInfo)
+	CreatePayout(ctx context.Context, request CreatePayoutRequest) (payout Payout, errorInfo pi.ErrorInfo)
+	CreatePrice(ctx context.Context, request CreatePriceRequest) (price Price, errorInfo pi.ErrorInfo)
+	CreateProduct(ctx context.Context, request CreateProductRequest) (product Product, errorInfo pi.ErrorInfo)
+	CreatePromotionCode(ctx context.Context, request CreatePromotionCodeRequest) (promotionCode PromotionCode, errorInfo pi.ErrorInfo)
+	CreateRefund(ctx context.Context, request CreateRefundRequest) (refund Refund, errorInfo pi.ErrorInfo)
+	CreateSetupIntent(ctx context.Context, request CreateSetupIntentRequest) (setupIntent SetupIntent, errorInfo pi.ErrorInfo)
+	CreateSubscription(ctx context.Context, request CreateSubscriptionRequest) (subscription Subscription, errorInfo pi.ErrorInfo)
+	DeactivatePaymentLink(ctx context.Context, request DeactivatePaymentLinkRequest) (paymentLink PaymentLink, errorInfo pi.ErrorInfo)
+	DeactivatePromotionCode(ctx context.Context, request DeactivatePromotionCodeRequest) (promotionCode PromotionCode, errorInfo pi.ErrorInfo)
+	DeleteCoupon(ctx context.Context, request DeleteCouponRequest) (deletedResult DeletedResult, errorInfo pi.ErrorInfo)
+	DeleteCustomer(ctx context.Context, request DeleteCustomerRequest) (deletedResult DeletedResult, errorInfo pi.ErrorInfo)
+	DeleteInvoiceItem(ctx context.Context, request DeleteInvoiceItemRequest) (deletedResult DeletedResult, errorInfo pi.ErrorInfo)
+	DetachPaymentMethod(ctx context.Context, request DetachPaymentMethodRequest) (paymentMethod PaymentMethod, errorInfo pi.ErrorInfo)
+	ExpireCheckoutSession(ctx context.Context, request ExpireCheckoutSessionRequest) (checkoutSession CheckoutSession, errorInfo pi.ErrorInfo)
+	FinalizeInvoice(ctx context.Context, request FinalizeInvoiceRequest) (invoice Invoice, errorInfo pi.ErrorInfo)
+	GetBalance(ctx context.Context, request GetBalanceRequest) (balance Balance, errorInfo pi.ErrorInfo)
+	GetCheckoutSession(ctx context.Context, request GetCheckoutSessionRequest) (checkoutSession CheckoutSession, errorInfo pi.ErrorInfo)
+	GetCoupon(ctx context.Context, request GetCouponRequest) (coupon Coupon, errorInfo pi.ErrorInfo)
+	GetCustomer(ctx context.Context, request GetCustomerRequest) (customer Customer, errorInfo pi.ErrorInfo)
+	GetDispute(ctx context.Context, request GetDisputeRequest) (dispute Dispute, errorInfo pi.ErrorInfo)
+	GetInvoice(ctx context.Context, request GetInvoiceRequest) (invoice Invoice, errorInfo pi.ErrorInfo)
+	GetPaymentIntent(ctx context.Context, request GetPaymentIntentRequest) (paymentIntent PaymentIntent, errorInfo pi.ErrorInfo)
+	GetPayout(ctx context.Context, request GetPayoutRequest) (payout Payout, errorInfo pi.ErrorInfo)
+	GetPrice(ctx context.Context, request GetPriceRequest) (price Price, errorInfo pi.ErrorInfo)
+	GetProduct(ctx context.Context, request GetProductRequest) (product Product, errorInfo pi.ErrorInfo)
+	GetPromotionCode(ctx context.Context, request GetPromotionCodeRequest) (promotionCode PromotionCode, errorInfo pi.ErrorInfo)
+	GetRefund(ctx context.Context, request GetRefundRequest) (refund Refund, errorInfo pi.ErrorInfo)
+	GetSetupIntent(ctx context.Context, request GetSetupIntentRequest) (setupIntent SetupIntent, errorInfo pi.ErrorInfo)
+	GetSubscription(ctx context.Context, request GetSubscriptionRequest) (subscription Subscription, errorInfo pi.ErrorInfo)
+	ListBalanceTransactions(ctx context.Context, request ListBalanceTransactionsRequest) (balanceTransactionList BalanceTransactionList, errorInfo pi.ErrorInfo)
+	ListCoupons(ctx context.Context, request ListCouponsRequest) (couponList CouponList, errorInfo pi.ErrorInfo)
+	ListCustomerPaymentMethods(ctx context.Context, request ListCustomerPaymentMethodsRequest) (paymentMethodList PaymentMethodList, errorInfo pi.ErrorInfo)
+	ListCustomers(ctx context.Context, request ListCustomersRequest) (customerList CustomerList, errorInfo pi.ErrorInfo)
+	ListDisputes(ctx context.Context, request ListDisputesRequest) (disputeList DisputeList, errorInfo pi.ErrorInfo)
+	ListInvoiceItems(ctx context.Context, request ListInvoiceItemsRequest) (invoiceItemList InvoiceItemList, errorInfo pi.ErrorInfo)
+	ListInvoices(ctx context.Context, request ListInvoicesRequest) (invoiceList InvoiceList, errorInfo pi.ErrorInfo)
+	ListPaymentIntents(ctx context.Context, request ListPaymentIntentRequest) (paymentIntentList PaymentIntentList, errorInfo pi.ErrorInfo)
+	ListPaymentLinks(ctx context.Context, request ListPaymentLinksRequest) (paymentLinkList PaymentLinkList, errorInfo pi.ErrorInfo)
+	ListPaymentMethods(ctx context.Context, request ListPaymentMethodRequest) (paymentMethodList PaymentMethodList, errorInfo pi.ErrorInfo)
+	ListPayouts(ctx context.Context, request ListPayoutsRequest) (payoutList PayoutList, errorInfo pi.ErrorInfo)
+	ListPrices(ctx context.Context, request ListPricesRequest) (priceList PriceList, errorInfo pi.ErrorInfo)
+	ListProducts(ctx context.Context, request ListProductsRequest) (productList ProductList, errorInfo pi.ErrorInfo)
+	ListPromotionCodes(ctx context.Context, request ListPromotionCodesRequest) (promotionCodeList PromotionCodeList, errorInfo pi.ErrorInfo)
+	ListRefunds(ctx context.Context, request ListRefundsRequest) (refundList RefundList, errorInfo pi.ErrorInfo)
+	ListSetupIntents(ctx context.Context, request ListSetupIntentsRequest) (setupIntentList SetupIntentList, errorInfo pi.ErrorInfo)
+	ListSubscriptions(ctx context.Context, request ListSubscriptionsRequest) (subscriptionList SubscriptionList, errorInfo pi.ErrorInfo)
+	MarkInvoiceUncollectible(ctx context.Context, request MarkInvoiceUncollectibleRequest) (invoice Invoice, errorInfo pi.ErrorInfo)
+	PayInvoice(ctx context.Context, request PayInvoiceRequest) (invoice Invoice, errorInfo pi.ErrorInfo)
+	SendInvoice(ctx context.Context, request SendInvoiceRequest) (invoice Invoice, errorInfo pi.ErrorInfo)
+	SetDefaultPaymentMethod(ctx context.Context, request SetDefaultPaymentMethodRequest) (customer Customer, errorInfo pi.ErrorInfo)
+	UpdateCoupon(ctx context.Context, request UpdateCouponRequest) (coupon Coupon, errorInfo pi.ErrorInfo)
+	UpdateCustomer(ctx context.Context, request UpdateCustomerRequest) (customer Customer, errorInfo pi.ErrorInfo)
+	UpdateDispute(ctx context.Context, request UpdateDisputeRequest) (dispute Dispute, errorInfo pi.ErrorInfo)
+	UpdatePaymentIntent(ctx context.Context, request UpdatePaymentIntentRequest) (paymentIntent PaymentIntent, errorInfo pi.ErrorInfo)
+	UpdatePaymentLink(ctx context.Context, request UpdatePaymentLinkRequest) (paymentLink PaymentLink, errorInfo pi.ErrorInfo)
+	UpdatePrice(ctx context.Context, request UpdatePriceRequest) (price Price, errorInfo pi.ErrorInfo)
+	UpdateProduct(ctx context.Context, request UpdateProductRequest) (product Product, errorInfo pi.ErrorInfo)
+	UpdatePromotionCode(ctx context.Context, request UpdatePromotionCodeRequest) (promotionCode PromotionCode, errorInfo pi.ErrorInfo)
+	UpdateSubscription(ctx context.Context, request UpdateSubscriptionRequest) (subscription Subscription, errorInfo pi.ErrorInfo)
+	VoidInvoice(ctx context.Context, request VoidInvoiceRequest) (invoice Invoice, errorInfo pi.ErrorInfo)
+}
+
+var _ PaymentClient = (*Ai2CClient)(nil)
@@ -0,0 +1,282 @@
+// Package src
+/*
+These are the payment link operations of the Ai2CClient.
+
+RESTRICTIONS:
+	None
+
+NOTES:
+    A payment link is a reusable URL to a Stripe hosted payment page. Each customer who opens it gets their own
+    checkout session. Payment links cannot be deleted. DeactivatePaymentLink sets Active to false, which stops new
+    payments through the link.
+
+    After the payment, the customer is shown a confirmation page or, with the redirect type, sent to the
+    AfterCompletion URL, which is validated the same way as the checkout session URLs.
+
+COPYRIGHT:
+	Copyright 2022
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+
+*/
+package src
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	ctv "github.com/sty-holdings/constant-type-vars-go/v2024"
+	pi "github.com/sty-holdings/sty-shared/v2024/programInfo"
+)
+
+//goland:noinspection ALL
+const (
+	AFTER_COMPLETION_TYPE_HOSTED_CONFIRMATION = "hosted_confirmation"
+	AFTER_COMPLETION_TYPE_REDIRECT            = "redirect"
+)
+
+//goland:noinspection ALL
+const (
+	FN_PAYMENT_LINK_ID = "payment_link_id"
+	FN_REDIRECT_URL    = "redirect_url"
+)
+
+//goland:noinspection ALL
+const (
+	SUB_STRIPE_CREATE_PAYMENT_LINK = "stripe.payment-link.create"
+	SUB_STRIPE_LIST_PAYMENT_LINKS  = "stripe.payment-link.list"
+	SUB_STRIPE_UPDATE_PAYMENT_LINK = "stripe.payment-link.update"
+)
+
+//goland:noinspection ALL
+const (
+	TXT_AFTER_COMPLETION_TYPE = "After completion type: "
+	TXT_PAYMENT_LINK          = "Payment link: "
+)
+
+var (
+	ErrAfterCompletionTypeInvalid = errors.New("the after completion type must be hosted_confirmation or redirect")
+)
+
+// CreatePaymentLinkRequest - each line item is Quantity units of PriceId. AfterCompletion defaults to the hosted
+// confirmation page.
+type CreatePaymentLinkRequest struct {
+	SaaSKey            string                       `json:"saas_key"`
+	LineItems          []PaymentLinkLineItemRequest `json:"line_items"`
+	AfterCompletion    *PaymentLinkAfterCompletion  `json:"after_completion,omitempty"`
+	Metadata           map[string]string            `json:"metadata,omitempty"`
+	PaymentMethodTypes []string                     `json:"payment_method_types,omitempty"`
+}
+
+type DeactivatePaymentLinkRequest struct {
+	SaaSKey       string `json:"saas_key"`
+	PaymentLinkId string `json:"id"`
+}
+
+// ListPaymentLinksRequest - Active only returns the payment links that are active, true, or deactivated, false.
+type ListPaymentLinksRequest struct {
+	SaaSKey       string `json:"saas_key"`
+	Active        *bool  `json:"active,omitempty"`
+	Limit         int64  `json:"limit,omitempty"`
+	StartingAfter string `json:"starting_after,omitempty"`
+}
+
+type PaymentLink struct {
+	Id              string                      `json:"id"`
+	Object          string                      `json:"object,omitempty"`
+	Active          bool                        `json:"active"`
+	AfterCompletion *PaymentLinkAfterCompletion `json:"after_completion,omitempty"`
+	Currency        string                      `json:"currency,omitempty"`
+	LiveMode        bool                        `json:"livemode,omitempty"`
+	Metadata        map[string]string           `json:"metadata,omitempty"`
+	URL             string                      `json:"url"`
+	RawReply
+}
+
+// PaymentLinkAfterCompletion - Type is one of the AFTER_COMPLETION_TYPE values. The redirect type requires
+// Redirect.URL.
+type PaymentLinkAfterCompletion struct {
+	Type     string               `json:"type"`
+	Redirect *PaymentLinkRedirect `json:"redirect,omitempty"`
+}
+
+// PaymentLinkLineItemRequest - Quantity must be at least 1.
+type PaymentLinkLineItemRequest struct {
+	PriceId  string `json:"price"`
+	Quantity int64  `json:"quantity"`
+}
+
+type PaymentLinkList = List[PaymentLink]
+
+type PaymentLinkRedirect struct {
+	URL string `json:"url"`
+}
+
+// UpdatePaymentLinkRequest - only the fields that are set are changed. Metadata keys are added or replaced, and a key
+// with an empty value is removed.
+type UpdatePaymentLinkRequest struct {
+	SaaSKey         string                      `json:"saas_key"`
+	PaymentLinkId   string                      `json:"id"`
+	Active          *bool                       `json:"active,omitempty"`
+	AfterCompletion *PaymentLinkAfterCompletion `json:"after_completion,omitempty"`
+	Metadata        map[string]string           `json:"metadata,omitempty"`
+}
+
+// CreatePaymentLink - creates a payment link and returns it with its URL. The SaaSKey and at least one line item
+// with a PriceId and a positive Quantity are required.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing, ErrQuantityInvalid, ErrAfterCompletionTypeInvalid, ErrURLInvalid
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) CreatePaymentLink(ctx context.Context, request CreatePaymentLinkRequest) (
+	paymentLink PaymentLink,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if len(request.LineItems) == ctv.VAL_ZERO {
+		errorInfo = missingParameter(FN_LINE_ITEMS)
+		return
+	}
+	for _, lineItem := range request.LineItems {
+		if lineItem.PriceId == ctv.VAL_EMPTY {
+			errorInfo = missingParameter(FN_PRICE_ID)
+			return
+		}
+		if lineItem.Quantity <= 0 {
+			errorInfo = pi.NewErrorInfo(ErrQuantityInvalid, fmt.Sprintf("%v%v", TXT_QUANTITY, lineItem.Quantity))
+			return
+		}
+	}
+	if errorInfo = validateAfterCompletion(request.AfterCompletion); errorInfo.Error != nil {
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_CREATE_PAYMENT_LINK, request, &paymentLink)
+
+	return
+}
+
+// DeactivatePaymentLink - sets the payment link identified by PaymentLinkId to inactive and returns the updated
+// payment link. The SaaSKey and PaymentLinkId are required.
+//
+// Customer Messages: None
+// Errors: Errors returned by UpdatePaymentLink
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) DeactivatePaymentLink(ctx context.Context, request DeactivatePaymentLinkRequest) (
+	paymentLink PaymentLink,
+	errorInfo pi.ErrorInfo,
+) {
+
+	var (
+		tActive = false
+	)
+
+	return ai2cClientPtr.UpdatePaymentLink(
+		ctx,
+		UpdatePaymentLinkRequest{
+			SaaSKey:       request.SaaSKey,
+			PaymentLinkId: request.PaymentLinkId,
+			Active:        &tActive,
+		},
+	)
+}
+
+// ListPaymentLinks - lists payment links, newest first. The SaaSKey is required and the Limit must be set to a value
+// between 1 and 100. StartingAfter is the id of the payment link the list starts after. Use PaymentLinkList.Cursor
+// to get the next page.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing, ErrLimitOutOfRange
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) ListPaymentLinks(ctx context.Context, request ListPaymentLinksRequest) (
+	paymentLinkList PaymentLinkList,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if errorInfo = validateListLimit(request.Limit); errorInfo.Error != nil {
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_LIST_PAYMENT_LINKS, request, &paymentLinkList)
+
+	return
+}
+
+// UpdatePaymentLink - changes the payment link identified by PaymentLinkId and returns the updated payment link. The
+// SaaSKey, PaymentLinkId, and at least one change are required.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing, ErrNoChanges, ErrAfterCompletionTypeInvalid, ErrURLInvalid
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) UpdatePaymentLink(ctx context.Context, request UpdatePaymentLinkRequest) (
+	paymentLink PaymentLink,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if request.PaymentLinkId == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_PAYMENT_LINK_ID)
+		return
+	}
+	if request.Active == nil && request.AfterCompletion == nil && len(request.Metadata) == ctv.VAL_ZERO {
+		errorInfo = pi.NewErrorInfo(ErrNoChanges, fmt.Sprintf("%v%v", TXT_PAYMENT_LINK, request.PaymentLinkId))
+		return
+	}
+	if errorInfo = validateAfterCompletion(request.AfterCompletion); errorInfo.Error != nil {
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_UPDATE_PAYMENT_LINK, request, &paymentLink)
+
+	return
+}
+
+// Private Function below here
+
+// validateAfterCompletion - returns an error when the type is not an AFTER_COMPLETION_TYPE value, or the redirect
+// type is missing a valid URL. A nil afterCompletion is valid.
+//
+//	Customer Messages: None
+//	Errors: ErrRequiredArgumentMissing, ErrAfterCompletionTypeInvalid, ErrURLInvalid
+//	Verifications: None
+func validateAfterCompletion(afterCompletion *PaymentLinkAfterCompletion) (errorInfo pi.ErrorInfo) {
+
+	if afterCompletion == nil {
+		return
+	}
+
+	switch afterCompletion.Type {
+	case AFTER_COMPLETION_TYPE_HOSTED_CONFIRMATION:
+	case AFTER_COMPLETION_TYPE_REDIRECT:
+		if afterCompletion.Redirect == nil || afterCompletion.Redirect.URL == ctv.VAL_EMPTY {
+			errorInfo = missingParameter(FN_REDIRECT_URL)
+			return
+		}
+		errorInfo = validateURL(afterCompletion.Redirect.URL)
+	default:
+		errorInfo = pi.NewErrorInfo(ErrAfterCompletionTypeInvalid, fmt.Sprintf("%v%v", TXT_AFTER_COMPLETION_TYPE, afterCompletion.Type))
+	}
+
+	return
+}
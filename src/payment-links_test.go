@@ -0,0 +1,95 @@
+package src_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"ai2c-go-client/src"
+	pi "github.com/sty-holdings/sty-shared/v2024/programInfo"
+)
+
+func TestDeactivatePaymentLink(t *testing.T) {
+
+	var (
+		tSent src.UpdatePaymentLinkRequest
+	)
+
+	tServerPtr, tClientPtr := newFakeServerClient(t)
+	if errorInfo := tServerPtr.Reply(src.SUB_STRIPE_UPDATE_PAYMENT_LINK, src.PaymentLink{Id: "plink_1"}); errorInfo.Error != nil {
+		t.Fatalf("Reply error = %v", errorInfo.Error)
+	}
+
+	tPaymentLink, errorInfo := tClientPtr.DeactivatePaymentLink(
+		context.Background(),
+		src.DeactivatePaymentLinkRequest{SaaSKey: "sk_test", PaymentLinkId: "plink_1"},
+	)
+	if errorInfo.Error != nil {
+		t.Fatalf("DeactivatePaymentLink error = %v", errorInfo.Error)
+	}
+	if tPaymentLink.Id != "plink_1" || tPaymentLink.Active {
+		t.Errorf("DeactivatePaymentLink = %+v, want the inactive payment link", tPaymentLink)
+	}
+
+	tRequests := tServerPtr.Requests()
+	if len(tRequests) != 1 || tRequests[0].Subject != src.SUB_STRIPE_UPDATE_PAYMENT_LINK {
+		t.Fatalf("requests = %+v, want one %v request", tRequests, src.SUB_STRIPE_UPDATE_PAYMENT_LINK)
+	}
+	if err := json.Unmarshal(tRequests[0].Data, &tSent); err != nil {
+		t.Fatalf("json.Unmarshal(%s) error = %v", tRequests[0].Data, err)
+	}
+	if tSent.PaymentLinkId != "plink_1" || tSent.Active == nil || *tSent.Active {
+		t.Errorf("sent %s, want active set to false", tRequests[0].Data)
+	}
+}
+
+func TestUpdatePaymentLinkValidation(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request src.UpdatePaymentLinkRequest
+		wantErr error
+	}{
+		{name: "no saas key", request: src.UpdatePaymentLinkRequest{PaymentLinkId: "plink_1", Metadata: map[string]string{"a": "b"}}, wantErr: pi.ErrRequiredArgumentMissing},
+		{name: "no payment link", request: src.UpdatePaymentLinkRequest{SaaSKey: "sk_test", Metadata: map[string]string{"a": "b"}}, wantErr: pi.ErrRequiredArgumentMissing},
+		{name: "no changes", request: src.UpdatePaymentLinkRequest{SaaSKey: "sk_test", PaymentLinkId: "plink_1"}, wantErr: src.ErrNoChanges},
+		{
+			name: "invalid after completion type",
+			request: src.UpdatePaymentLinkRequest{
+				SaaSKey: "sk_test", PaymentLinkId: "plink_1", AfterCompletion: &src.PaymentLinkAfterCompletion{Type: "popup"},
+			},
+			wantErr: src.ErrAfterCompletionTypeInvalid,
+		},
+		{
+			name: "redirect without a URL",
+			request: src.UpdatePaymentLinkRequest{
+				SaaSKey: "sk_test", PaymentLinkId: "plink_1", AfterCompletion: &src.PaymentLinkAfterCompletion{Type: src.AFTER_COMPLETION_TYPE_REDIRECT},
+			},
+			wantErr: pi.ErrRequiredArgumentMissing,
+		},
+		{
+			name: "relative redirect URL",
+			request: src.UpdatePaymentLinkRequest{
+				SaaSKey:         "sk_test",
+				PaymentLinkId:   "plink_1",
+				AfterCompletion: &src.PaymentLinkAfterCompletion{Type: src.AFTER_COMPLETION_TYPE_REDIRECT, Redirect: &src.PaymentLinkRedirect{URL: "/done"}},
+			},
+			wantErr: src.ErrURLInvalid,
+		},
+	}
+
+	tServerPtr, tClientPtr := newFakeServerClient(t)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, errorInfo := tClientPtr.UpdatePaymentLink(context.Background(), tt.request); errors.Is(errorInfo.Error, tt.wantErr) == false {
+				t.Errorf("UpdatePaymentLink(%+v) error = %v, want %v", tt.request, errorInfo.Error, tt.wantErr)
+			}
+		})
+	}
+
+	if tRequests := tServerPtr.Requests(); len(tRequests) != 0 {
+		t.Errorf("requests = %+v, want none", tRequests)
+	}
+}
@@ -0,0 +1,241 @@
+// Package src
+/*
+These are the payout operations of the Ai2CClient.
+
+RESTRICTIONS:
+	None
+
+NOTES:
+    A payout moves available balance to the account's bank account or debit card. Payouts are normally created
+    automatically on the account's payout schedule. CreatePayout is for accounts with manual payouts.
+
+COPYRIGHT:
+	Copyright 2022
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+
+*/
+package src
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	ctv "github.com/sty-holdings/constant-type-vars-go/v2024"
+	pi "github.com/sty-holdings/sty-shared/v2024/programInfo"
+)
+
+//goland:noinspection ALL
+const (
+	PAYOUT_METHOD_INSTANT  = "instant"
+	PAYOUT_METHOD_STANDARD = "standard"
+)
+
+//goland:noinspection ALL
+const (
+	PAYOUT_STATUS_CANCELED   = "canceled"
+	PAYOUT_STATUS_FAILED     = "failed"
+	PAYOUT_STATUS_IN_TRANSIT = "in_transit"
+	PAYOUT_STATUS_PAID       = "paid"
+	PAYOUT_STATUS_PENDING    = "pending"
+)
+
+//goland:noinspection ALL
+const (
+	FN_PAYOUT_ID = "payout_id"
+)
+
+//goland:noinspection ALL
+const (
+	SUB_STRIPE_CREATE_PAYOUT = "stripe.payout.create"
+	SUB_STRIPE_GET_PAYOUT    = "stripe.payout.get"
+	SUB_STRIPE_LIST_PAYOUTS  = "stripe.payout.list"
+)
+
+//goland:noinspection ALL
+const (
+	TXT_PAYOUT_METHOD = "Payout method: "
+)
+
+var (
+	ErrPayoutMethodInvalid = errors.New("the payout method must be instant or standard")
+)
+
+// CreatePayoutRequest - DestinationId is a bank account or card of the account. When it is empty, the default for
+// the currency is used. Method defaults to standard.
+type CreatePayoutRequest struct {
+	SaaSKey             string            `json:"saas_key"`
+	Amount              Money             `json:"-"`
+	Description         string            `json:"description,omitempty"`
+	DestinationId       string            `json:"destination,omitempty"`
+	Metadata            map[string]string `json:"metadata,omitempty"`
+	Method              string            `json:"method,omitempty"`
+	StatementDescriptor string            `json:"statement_descriptor,omitempty"`
+}
+
+type GetPayoutRequest struct {
+	SaaSKey  string `json:"saas_key"`
+	PayoutId string `json:"id"`
+}
+
+// ListPayoutsRequest - ArrivalDate, Created, DestinationId, and Status only return the payouts that match them.
+type ListPayoutsRequest struct {
+	SaaSKey       string     `json:"saas_key"`
+	ArrivalDate   *DateRange `json:"arrival_date,omitempty"`
+	Created       *DateRange `json:"created,omitempty"`
+	DestinationId string     `json:"destination,omitempty"`
+	Status        string     `json:"status,omitempty"`
+	Limit         int64      `json:"limit,omitempty"`
+	StartingAfter string     `json:"starting_after,omitempty"`
+}
+
+// Payout - ArrivalDate is the Unix timestamp the payout is expected in the bank. Automatic is true for payouts
+// created on the payout schedule.
+type Payout struct {
+	Id                   string            `json:"id"`
+	Object               string            `json:"object,omitempty"`
+	Amount               int64             `json:"amount"`
+	ArrivalDate          int64             `json:"arrival_date,omitempty"`
+	Automatic            bool              `json:"automatic,omitempty"`
+	BalanceTransactionId string            `json:"balance_transaction,omitempty"`
+	Created              int64             `json:"created,omitempty"`
+	Currency             string            `json:"currency"`
+	Description          string            `json:"description,omitempty"`
+	DestinationId        string            `json:"destination,omitempty"`
+	FailureCode          string            `json:"failure_code,omitempty"`
+	FailureMessage       string            `json:"failure_message,omitempty"`
+	LiveMode             bool              `json:"livemode,omitempty"`
+	Metadata             map[string]string `json:"metadata,omitempty"`
+	Method               string            `json:"method,omitempty"`
+	StatementDescriptor  string            `json:"statement_descriptor,omitempty"`
+	Status               string            `json:"status"`
+	Type                 string            `json:"type,omitempty"`
+	RawReply
+}
+
+type PayoutList = List[Payout]
+
+// CreatePayout - pays out Amount from the available balance and returns the payout. The SaaSKey and an Amount
+// greater than zero are required. When Method is set, it must be instant or standard.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing, ErrAmountNotPositive, ErrCurrencyInvalid, ErrPayoutMethodInvalid
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) CreatePayout(ctx context.Context, request CreatePayoutRequest) (
+	payout Payout,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if errorInfo = validateAmount(request.Amount); errorInfo.Error != nil {
+		return
+	}
+	switch request.Method {
+	case ctv.VAL_EMPTY, PAYOUT_METHOD_INSTANT, PAYOUT_METHOD_STANDARD:
+	default:
+		errorInfo = pi.NewErrorInfo(ErrPayoutMethodInvalid, fmt.Sprintf("%v%v", TXT_PAYOUT_METHOD, request.Method))
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_CREATE_PAYOUT, request, &payout)
+
+	return
+}
+
+// GetPayout - returns the payout identified by PayoutId. The SaaSKey and PayoutId are required.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) GetPayout(ctx context.Context, request GetPayoutRequest) (
+	payout Payout,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if request.PayoutId == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_PAYOUT_ID)
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_GET_PAYOUT, request, &payout)
+
+	return
+}
+
+// ListPayouts - lists payouts, newest first. The SaaSKey is required and the Limit must be set to a value between 1
+// and 100. StartingAfter is the id of the payout the list starts after. Use PayoutList.Cursor to get the next page.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing, ErrLimitOutOfRange, ErrDateRangeInvalid
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) ListPayouts(ctx context.Context, request ListPayoutsRequest) (
+	payoutList PayoutList,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if errorInfo = validateListLimit(request.Limit); errorInfo.Error != nil {
+		return
+	}
+	if errorInfo = validateDateRange(request.ArrivalDate); errorInfo.Error != nil {
+		return
+	}
+	if errorInfo = validateDateRange(request.Created); errorInfo.Error != nil {
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_LIST_PAYOUTS, request, &payoutList)
+
+	return
+}
+
+// MarshalJSON - encodes the request with Amount as a decimal in the major unit and the currency next to it.
+//
+// Customer Messages: None
+// Errors: json errors
+// Verifications: None
+func (request CreatePayoutRequest) MarshalJSON() ([]byte, error) {
+
+	type tCreatePayoutRequest CreatePayoutRequest
+
+	return json.Marshal(struct {
+		tCreatePayoutRequest
+		Amount   json.Number `json:"amount"`
+		Currency string      `json:"currency"`
+	}{
+		tCreatePayoutRequest: tCreatePayoutRequest(request),
+		Amount:               json.Number(request.Amount.Decimal()),
+		Currency:             request.Amount.Currency,
+	})
+}
+
+// AmountMoney - returns Amount, which is in minor units, with the currency as Money.
+//
+// Customer Messages: None
+// Errors: None
+// Verifications: None
+func (payoutPtr *Payout) AmountMoney() (amount Money) {
+
+	return replyMoney(payoutPtr.Amount, payoutPtr.Currency)
+}
@@ -0,0 +1,580 @@
+// Package src
+/*
+These are the price operations of the Ai2CClient.
+
+RESTRICTIONS:
+	None
+
+NOTES:
+    A price is one-time, or recurring when Recurring is set. A per_unit price charges UnitAmount for each unit. A
+    tiered price charges by the Tiers, either all units at the tier the quantity reaches, volume, or each unit at the
+    tier it falls in, graduated.
+
+    CurrencyOptions holds the amounts of the price in other currencies, keyed by the lowercase currency code.
+
+    The amount and currency of a price cannot be changed once it is created. Create a new price and archive the old
+    one instead. ArchivePrice sets Active to false, so the price can no longer be used for new purchases.
+
+COPYRIGHT:
+	Copyright 2022
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+
+*/
+package src
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	ctv "github.com/sty-holdings/constant-type-vars-go/v2024"
+	pi "github.com/sty-holdings/sty-shared/v2024/programInfo"
+)
+
+//goland:noinspection ALL
+const (
+	PRICE_BILLING_SCHEME_PER_UNIT = "per_unit"
+	PRICE_BILLING_SCHEME_TIERED   = "tiered"
+)
+
+//goland:noinspection ALL
+const (
+	PRICE_INTERVAL_DAY   = "day"
+	PRICE_INTERVAL_MONTH = "month"
+	PRICE_INTERVAL_WEEK  = "week"
+	PRICE_INTERVAL_YEAR  = "year"
+)
+
+//goland:noinspection ALL
+const (
+	PRICE_TIERS_MODE_GRADUATED = "graduated"
+	PRICE_TIERS_MODE_VOLUME    = "volume"
+)
+
+//goland:noinspection ALL
+const (
+	PRICE_TYPE_ONE_TIME  = "one_time"
+	PRICE_TYPE_RECURRING = "recurring"
+)
+
+//goland:noinspection ALL
+const (
+	FN_TIERS       = "tiers"
+	FN_TIERS_MODE  = "tiers_mode"
+	FN_UNIT_AMOUNT = "unit_amount"
+)
+
+//goland:noinspection ALL
+const (
+	SUB_STRIPE_CREATE_PRICE = "stripe.price.create"
+	SUB_STRIPE_GET_PRICE    = "stripe.price.get"
+	SUB_STRIPE_LIST_PRICES  = "stripe.price.list"
+	SUB_STRIPE_UPDATE_PRICE = "stripe.price.update"
+)
+
+//goland:noinspection ALL
+const (
+	TXT_BILLING_SCHEME = "Billing scheme: "
+	TXT_INTERVAL       = "Interval: "
+	TXT_PRICE          = "Price: "
+	TXT_TIER           = "Tier: "
+	TXT_TIERS_MODE     = "Tiers mode: "
+)
+
+var (
+	ErrAmountNegative       = errors.New("the amount must not be negative")
+	ErrBillingSchemeInvalid = errors.New("the billing scheme must be per_unit or tiered")
+	ErrIntervalInvalid      = errors.New("the interval must be day, week, month, or year with a count that is not negative")
+	ErrTierUpToInvalid      = errors.New("the tier upper bounds must increase and only the last tier has no upper bound")
+	ErrTiersModeInvalid     = errors.New("the tiers mode must be graduated or volume")
+)
+
+type ArchivePriceRequest struct {
+	SaaSKey string `json:"saas_key"`
+	PriceId string `json:"id"`
+}
+
+// CreatePriceRequest - BillingScheme defaults to per_unit, which requires UnitAmount. A tiered price requires Tiers
+// and TiersMode. All the amounts must be in Currency. LookupKey is a stable name, for example pro_monthly, used to
+// find the price without storing its id.
+type CreatePriceRequest struct {
+	SaaSKey         string                                `json:"saas_key"`
+	ProductId       string                                `json:"product"`
+	Currency        string                                `json:"currency"`
+	BillingScheme   string                                `json:"billing_scheme,omitempty"`
+	CurrencyOptions map[string]PriceCurrencyOptionRequest `json:"currency_options,omitempty"`
+	LookupKey       string                                `json:"lookup_key,omitempty"`
+	Metadata        map[string]string                     `json:"metadata,omitempty"`
+	Nickname        string                                `json:"nickname,omitempty"`
+	Recurring       *PriceRecurring                       `json:"recurring,omitempty"`
+	Tiers           []PriceTierRequest                    `json:"tiers,omitempty"`
+	TiersMode       string                                `json:"tiers_mode,omitempty"`
+	UnitAmount      *Money                                `json:"-"`
+}
+
+type GetPriceRequest struct {
+	SaaSKey string `json:"saas_key"`
+	PriceId string `json:"id"`
+}
+
+// ListPricesRequest - Active, Currency, ProductId, and Type only return the prices that match them.
+type ListPricesRequest struct {
+	SaaSKey       string `json:"saas_key"`
+	Active        *bool  `json:"active,omitempty"`
+	Currency      string `json:"currency,omitempty"`
+	ProductId     string `json:"product,omitempty"`
+	Type          string `json:"type,omitempty"`
+	Limit         int64  `json:"limit,omitempty"`
+	StartingAfter string `json:"starting_after,omitempty"`
+}
+
+type Price struct {
+	Id              string                         `json:"id"`
+	Object          string                         `json:"object,omitempty"`
+	Active          bool                           `json:"active"`
+	BillingScheme   string                         `json:"billing_scheme,omitempty"`
+	Created         int64                          `json:"created,omitempty"`
+	Currency        string                         `json:"currency"`
+	CurrencyOptions map[string]PriceCurrencyOption `json:"currency_options,omitempty"`
+	LiveMode        bool                           `json:"livemode,omitempty"`
+	LookupKey       string                         `json:"lookup_key,omitempty"`
+	Metadata        map[string]string              `json:"metadata,omitempty"`
+	Nickname        string                         `json:"nickname,omitempty"`
+	ProductId       string                         `json:"product,omitempty"`
+	Recurring       *PriceRecurring                `json:"recurring,omitempty"`
+	Tiers           []PriceTier                    `json:"tiers,omitempty"`
+	TiersMode       string                         `json:"tiers_mode,omitempty"`
+	Type            string                         `json:"type,omitempty"`
+	UnitAmount      int64                          `json:"unit_amount,omitempty"`
+	RawReply
+}
+
+type PriceCurrencyOption struct {
+	Tiers      []PriceTier `json:"tiers,omitempty"`
+	UnitAmount int64       `json:"unit_amount,omitempty"`
+}
+
+// PriceCurrencyOptionRequest - the amounts must be in the currency the option is keyed by.
+type PriceCurrencyOptionRequest struct {
+	Tiers      []PriceTierRequest `json:"tiers,omitempty"`
+	UnitAmount *Money             `json:"-"`
+}
+
+type PriceList = List[Price]
+
+// PriceRecurring - Interval is one of the PRICE_INTERVAL values. IntervalCount is the number of intervals between
+// charges and defaults to 1.
+type PriceRecurring struct {
+	Interval      string `json:"interval"`
+	IntervalCount int64  `json:"interval_count,omitempty"`
+}
+
+// PriceTier - the amounts are in minor units. UpTo is zero for the last tier, which has no upper bound.
+type PriceTier struct {
+	FlatAmount int64 `json:"flat_amount,omitempty"`
+	UnitAmount int64 `json:"unit_amount,omitempty"`
+	UpTo       int64 `json:"up_to,omitempty"`
+}
+
+// PriceTierRequest - UpTo is the highest quantity in the tier. It is zero for the last tier, which has no upper
+// bound. FlatAmount is charged once for the tier and UnitAmount for each unit in it.
+type PriceTierRequest struct {
+	FlatAmount *Money
+	UnitAmount *Money
+	UpTo       int64
+}
+
+// UpdatePriceRequest - only the fields that are set are changed. Metadata keys are added or replaced, and a key with
+// an empty value is removed.
+type UpdatePriceRequest struct {
+	SaaSKey         string                                `json:"saas_key"`
+	PriceId         string                                `json:"id"`
+	Active          *bool                                 `json:"active,omitempty"`
+	CurrencyOptions map[string]PriceCurrencyOptionRequest `json:"currency_options,omitempty"`
+	LookupKey       *string                               `json:"lookup_key,omitempty"`
+	Metadata        map[string]string                     `json:"metadata,omitempty"`
+	Nickname        *string                               `json:"nickname,omitempty"`
+}
+
+// ArchivePrice - sets the price identified by PriceId to inactive and returns the updated price. The SaaSKey and
+// PriceId are required.
+//
+// Customer Messages: None
+// Errors: Errors returned by UpdatePrice
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) ArchivePrice(ctx context.Context, request ArchivePriceRequest) (
+	price Price,
+	errorInfo pi.ErrorInfo,
+) {
+
+	var (
+		tActive = false
+	)
+
+	return ai2cClientPtr.UpdatePrice(
+		ctx,
+		UpdatePriceRequest{
+			SaaSKey: request.SaaSKey,
+			PriceId: request.PriceId,
+			Active:  &tActive,
+		},
+	)
+}
+
+// CreatePrice - creates a price for the product. The SaaSKey, ProductId, and Currency are required. A per_unit
+// price requires UnitAmount and a tiered price requires Tiers and TiersMode. When Recurring is set, Interval must be
+// one of the PRICE_INTERVAL values.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing, ErrCurrencyInvalid, ErrCurrencyMismatch, ErrAmountNegative,
+// ErrBillingSchemeInvalid, ErrIntervalInvalid, ErrTiersModeInvalid, ErrTierUpToInvalid
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) CreatePrice(ctx context.Context, request CreatePriceRequest) (
+	price Price,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if request.ProductId == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_PRODUCT_ID)
+		return
+	}
+	if request.Currency == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_CURRENCY)
+		return
+	}
+	if isCurrencyValid(request.Currency) == false {
+		errorInfo = pi.NewErrorInfo(ErrCurrencyInvalid, fmt.Sprintf("%v%v", TXT_CURRENCY, request.Currency))
+		return
+	}
+	if request.Recurring != nil {
+		if errorInfo = validatePriceRecurring(*request.Recurring); errorInfo.Error != nil {
+			return
+		}
+	}
+	switch request.BillingScheme {
+	case ctv.VAL_EMPTY, PRICE_BILLING_SCHEME_PER_UNIT:
+		if request.UnitAmount == nil {
+			errorInfo = missingParameter(FN_UNIT_AMOUNT)
+			return
+		}
+		if errorInfo = validatePriceAmount(request.UnitAmount, request.Currency); errorInfo.Error != nil {
+			return
+		}
+	case PRICE_BILLING_SCHEME_TIERED:
+		switch request.TiersMode {
+		case ctv.VAL_EMPTY:
+			errorInfo = missingParameter(FN_TIERS_MODE)
+			return
+		case PRICE_TIERS_MODE_GRADUATED, PRICE_TIERS_MODE_VOLUME:
+		default:
+			errorInfo = pi.NewErrorInfo(ErrTiersModeInvalid, fmt.Sprintf("%v%v", TXT_TIERS_MODE, request.TiersMode))
+			return
+		}
+		if errorInfo = validatePriceTiers(request.Tiers, request.Currency); errorInfo.Error != nil {
+			return
+		}
+	default:
+		errorInfo = pi.NewErrorInfo(ErrBillingSchemeInvalid, fmt.Sprintf("%v%v", TXT_BILLING_SCHEME, request.BillingScheme))
+		return
+	}
+	if errorInfo = validateCurrencyOptions(request.CurrencyOptions); errorInfo.Error != nil {
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_CREATE_PRICE, request, &price)
+
+	return
+}
+
+// GetPrice - returns the price identified by PriceId. The SaaSKey and PriceId are required.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) GetPrice(ctx context.Context, request GetPriceRequest) (
+	price Price,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if request.PriceId == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_PRICE_ID)
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_GET_PRICE, request, &price)
+
+	return
+}
+
+// ListPrices - lists prices, newest first. The SaaSKey is required and the Limit must be set to a value between 1
+// and 100. StartingAfter is the id of the price the list starts after. Use PriceList.Cursor to get the next page.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing, ErrLimitOutOfRange
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) ListPrices(ctx context.Context, request ListPricesRequest) (
+	priceList PriceList,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if errorInfo = validateListLimit(request.Limit); errorInfo.Error != nil {
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_LIST_PRICES, request, &priceList)
+
+	return
+}
+
+// UpdatePrice - changes the price identified by PriceId and returns the updated price. The SaaSKey, PriceId, and at
+// least one change are required.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing, ErrNoChanges, ErrCurrencyMismatch, ErrAmountNegative, ErrTierUpToInvalid
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) UpdatePrice(ctx context.Context, request UpdatePriceRequest) (
+	price Price,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if request.PriceId == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_PRICE_ID)
+		return
+	}
+	if request.Active == nil && len(request.CurrencyOptions) == ctv.VAL_ZERO && request.LookupKey == nil &&
+		len(request.Metadata) == ctv.VAL_ZERO && request.Nickname == nil {
+		errorInfo = pi.NewErrorInfo(ErrNoChanges, fmt.Sprintf("%v%v", TXT_PRICE, request.PriceId))
+		return
+	}
+	if errorInfo = validateCurrencyOptions(request.CurrencyOptions); errorInfo.Error != nil {
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_UPDATE_PRICE, request, &price)
+
+	return
+}
+
+// MarshalJSON - encodes the request with UnitAmount as a decimal in the major unit. It is left out when UnitAmount
+// is nil.
+//
+// Customer Messages: None
+// Errors: json errors
+// Verifications: None
+func (request CreatePriceRequest) MarshalJSON() ([]byte, error) {
+
+	type tCreatePriceRequest CreatePriceRequest
+
+	var (
+		tUnitAmount json.Number
+	)
+
+	tUnitAmount, _ = decimalAmount(request.UnitAmount)
+
+	return json.Marshal(struct {
+		tCreatePriceRequest
+		UnitAmount json.Number `json:"unit_amount,omitempty"`
+	}{
+		tCreatePriceRequest: tCreatePriceRequest(request),
+		UnitAmount:          tUnitAmount,
+	})
+}
+
+// MarshalJSON - encodes the option with UnitAmount as a decimal in the major unit. It is left out when UnitAmount
+// is nil.
+//
+// Customer Messages: None
+// Errors: json errors
+// Verifications: None
+func (option PriceCurrencyOptionRequest) MarshalJSON() ([]byte, error) {
+
+	type tPriceCurrencyOptionRequest PriceCurrencyOptionRequest
+
+	var (
+		tUnitAmount json.Number
+	)
+
+	tUnitAmount, _ = decimalAmount(option.UnitAmount)
+
+	return json.Marshal(struct {
+		tPriceCurrencyOptionRequest
+		UnitAmount json.Number `json:"unit_amount,omitempty"`
+	}{
+		tPriceCurrencyOptionRequest: tPriceCurrencyOptionRequest(option),
+		UnitAmount:                  tUnitAmount,
+	})
+}
+
+// MarshalJSON - encodes the tier with the amounts as a decimal in the major unit. A tier without an upper bound is
+// sent with up_to set to inf.
+//
+// Customer Messages: None
+// Errors: json errors
+// Verifications: None
+func (tier PriceTierRequest) MarshalJSON() ([]byte, error) {
+
+	var (
+		tFlatAmount json.Number
+		tUnitAmount json.Number
+		tUpTo       interface{} = "inf"
+	)
+
+	tFlatAmount, _ = decimalAmount(tier.FlatAmount)
+	tUnitAmount, _ = decimalAmount(tier.UnitAmount)
+	if tier.UpTo > 0 {
+		tUpTo = tier.UpTo
+	}
+
+	return json.Marshal(struct {
+		FlatAmount json.Number `json:"flat_amount,omitempty"`
+		UnitAmount json.Number `json:"unit_amount,omitempty"`
+		UpTo       interface{} `json:"up_to"`
+	}{
+		FlatAmount: tFlatAmount,
+		UnitAmount: tUnitAmount,
+		UpTo:       tUpTo,
+	})
+}
+
+// UnitAmountMoney - returns UnitAmount, which is in minor units, with the currency as Money.
+//
+// Customer Messages: None
+// Errors: None
+// Verifications: None
+func (pricePtr *Price) UnitAmountMoney() (unitAmount Money) {
+
+	return replyMoney(pricePtr.UnitAmount, pricePtr.Currency)
+}
+
+// Private Function below here
+
+// validateCurrencyOptions - returns an error when an option's amounts are negative or not in the currency the option
+// is keyed by, or its tiers are invalid.
+//
+//	Customer Messages: None
+//	Errors: ErrCurrencyInvalid, ErrCurrencyMismatch, ErrAmountNegative, ErrTierUpToInvalid
+//	Verifications: None
+func validateCurrencyOptions(currencyOptions map[string]PriceCurrencyOptionRequest) (errorInfo pi.ErrorInfo) {
+
+	for currency, option := range currencyOptions {
+		if isCurrencyValid(currency) == false {
+			errorInfo = pi.NewErrorInfo(ErrCurrencyInvalid, fmt.Sprintf("%v%v", TXT_CURRENCY, currency))
+			return
+		}
+		if errorInfo = validatePriceAmount(option.UnitAmount, currency); errorInfo.Error != nil {
+			return
+		}
+		if len(option.Tiers) > ctv.VAL_ZERO {
+			if errorInfo = validatePriceTiers(option.Tiers, currency); errorInfo.Error != nil {
+				return
+			}
+		}
+	}
+
+	return
+}
+
+// validatePriceAmount - returns an error when the amount is negative or not in the currency. A nil amount is valid.
+//
+//	Customer Messages: None
+//	Errors: ErrAmountNegative, ErrCurrencyMismatch
+//	Verifications: None
+func validatePriceAmount(amountPtr *Money, currency string) (errorInfo pi.ErrorInfo) {
+
+	if amountPtr == nil {
+		return
+	}
+	if amountPtr.IsNegative() {
+		errorInfo = pi.NewErrorInfo(ErrAmountNegative, fmt.Sprintf("%v%v", TXT_AMOUNT, *amountPtr))
+		return
+	}
+	if strings.EqualFold(amountPtr.Currency, currency) == false {
+		errorInfo = pi.NewErrorInfo(ErrCurrencyMismatch, fmt.Sprintf("%v%v, %v", TXT_CURRENCY, amountPtr.Currency, currency))
+	}
+
+	return
+}
+
+// validatePriceRecurring - returns an error unless the interval is one of the PRICE_INTERVAL values and the interval
+// count is not negative.
+//
+//	Customer Messages: None
+//	Errors: ErrIntervalInvalid
+//	Verifications: None
+func validatePriceRecurring(recurring PriceRecurring) (errorInfo pi.ErrorInfo) {
+
+	switch recurring.Interval {
+	case PRICE_INTERVAL_DAY, PRICE_INTERVAL_MONTH, PRICE_INTERVAL_WEEK, PRICE_INTERVAL_YEAR:
+	default:
+		errorInfo = pi.NewErrorInfo(ErrIntervalInvalid, fmt.Sprintf("%v%v", TXT_INTERVAL, recurring.Interval))
+		return
+	}
+	if recurring.IntervalCount < 0 {
+		errorInfo = pi.NewErrorInfo(ErrIntervalInvalid, fmt.Sprintf("%v%v %v", TXT_INTERVAL, recurring.IntervalCount, recurring.Interval))
+	}
+
+	return
+}
+
+// validatePriceTiers - returns an error when there are no tiers, a tier's amounts are invalid, or the upper bounds
+// do not increase with only the last tier left unbounded.
+//
+//	Customer Messages: None
+//	Errors: ErrRequiredArgumentMissing, ErrAmountNegative, ErrCurrencyMismatch, ErrTierUpToInvalid
+//	Verifications: None
+func validatePriceTiers(tiers []PriceTierRequest, currency string) (errorInfo pi.ErrorInfo) {
+
+	var (
+		tLastIndex = len(tiers) - 1
+		tUpTo      int64
+	)
+
+	if len(tiers) == ctv.VAL_ZERO {
+		errorInfo = missingParameter(FN_TIERS)
+		return
+	}
+
+	for index, tier := range tiers {
+		if errorInfo = validatePriceAmount(tier.FlatAmount, currency); errorInfo.Error != nil {
+			return
+		}
+		if errorInfo = validatePriceAmount(tier.UnitAmount, currency); errorInfo.Error != nil {
+			return
+		}
+		if (index == tLastIndex && tier.UpTo != 0) || (index < tLastIndex && tier.UpTo <= tUpTo) {
+			errorInfo = pi.NewErrorInfo(ErrTierUpToInvalid, fmt.Sprintf("%v%v", TXT_TIER, index+1))
+			return
+		}
+		tUpTo = tier.UpTo
+	}
+
+	return
+}
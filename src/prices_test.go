@@ -0,0 +1,123 @@
+package src
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	pi "github.com/sty-holdings/sty-shared/v2024/programInfo"
+)
+
+func TestValidatePriceTiers(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		tiers   []PriceTierRequest
+		wantErr error
+	}{
+		{name: "increasing with an unbounded last tier", tiers: []PriceTierRequest{
+			{UnitAmount: &Money{Amount: 1000, Currency: "usd"}, UpTo: 5},
+			{UnitAmount: &Money{Amount: 800, Currency: "usd"}, UpTo: 10},
+			{FlatAmount: &Money{Amount: 500, Currency: "usd"}, UnitAmount: &Money{Amount: 500, Currency: "usd"}},
+		}},
+		{name: "one unbounded tier", tiers: []PriceTierRequest{{UnitAmount: &Money{Amount: 1000, Currency: "usd"}}}},
+		{name: "no tiers", tiers: nil, wantErr: pi.ErrRequiredArgumentMissing},
+		{name: "equal upper bounds", tiers: []PriceTierRequest{{UpTo: 5}, {UpTo: 5}, {}}, wantErr: ErrTierUpToInvalid},
+		{name: "decreasing upper bounds", tiers: []PriceTierRequest{{UpTo: 10}, {UpTo: 5}, {}}, wantErr: ErrTierUpToInvalid},
+		{name: "unbounded tier before the last", tiers: []PriceTierRequest{{}, {UpTo: 5}}, wantErr: ErrTierUpToInvalid},
+		{name: "bounded last tier", tiers: []PriceTierRequest{{UpTo: 5}, {UpTo: 10}}, wantErr: ErrTierUpToInvalid},
+		{name: "negative amount", tiers: []PriceTierRequest{{UnitAmount: &Money{Amount: -1, Currency: "usd"}}}, wantErr: ErrAmountNegative},
+		{name: "currency mismatch", tiers: []PriceTierRequest{{FlatAmount: &Money{Amount: 100, Currency: "eur"}}}, wantErr: ErrCurrencyMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if errorInfo := validatePriceTiers(tt.tiers, "usd"); errors.Is(errorInfo.Error, tt.wantErr) == false {
+				t.Errorf("validatePriceTiers(%+v) error = %v, want %v", tt.tiers, errorInfo.Error, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateCurrencyOptions(t *testing.T) {
+
+	tests := []struct {
+		name            string
+		currencyOptions map[string]PriceCurrencyOptionRequest
+		wantErr         error
+	}{
+		{name: "none", currencyOptions: nil},
+		{name: "unit amount", currencyOptions: map[string]PriceCurrencyOptionRequest{"eur": {UnitAmount: &Money{Amount: 900, Currency: "eur"}}}},
+		{name: "upper case amount currency", currencyOptions: map[string]PriceCurrencyOptionRequest{"eur": {UnitAmount: &Money{Amount: 900, Currency: "EUR"}}}},
+		{name: "tiers", currencyOptions: map[string]PriceCurrencyOptionRequest{"jpy": {Tiers: []PriceTierRequest{{UnitAmount: &Money{Amount: 100, Currency: "jpy"}, UpTo: 5}, {}}}}},
+		{name: "invalid key", currencyOptions: map[string]PriceCurrencyOptionRequest{"euro": {UnitAmount: &Money{Amount: 900, Currency: "eur"}}}, wantErr: ErrCurrencyInvalid},
+		{name: "unit amount in another currency", currencyOptions: map[string]PriceCurrencyOptionRequest{"eur": {UnitAmount: &Money{Amount: 900, Currency: "usd"}}}, wantErr: ErrCurrencyMismatch},
+		{name: "tier in another currency", currencyOptions: map[string]PriceCurrencyOptionRequest{"eur": {Tiers: []PriceTierRequest{{UnitAmount: &Money{Amount: 900, Currency: "gbp"}}}}}, wantErr: ErrCurrencyMismatch},
+		{name: "negative amount", currencyOptions: map[string]PriceCurrencyOptionRequest{"eur": {UnitAmount: &Money{Amount: -900, Currency: "eur"}}}, wantErr: ErrAmountNegative},
+		{name: "invalid tiers", currencyOptions: map[string]PriceCurrencyOptionRequest{"eur": {Tiers: []PriceTierRequest{{UpTo: 5}, {UpTo: 5}}}}, wantErr: ErrTierUpToInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if errorInfo := validateCurrencyOptions(tt.currencyOptions); errors.Is(errorInfo.Error, tt.wantErr) == false {
+				t.Errorf("validateCurrencyOptions(%+v) error = %v, want %v", tt.currencyOptions, errorInfo.Error, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPriceTierRequestMarshalJSON(t *testing.T) {
+
+	tests := []struct {
+		name string
+		tier PriceTierRequest
+		want string
+	}{
+		{name: "bounded", tier: PriceTierRequest{UnitAmount: &Money{Amount: 1050, Currency: "usd"}, UpTo: 10}, want: `{"unit_amount":10.50,"up_to":10}`},
+		{name: "unbounded", tier: PriceTierRequest{UnitAmount: &Money{Amount: 800, Currency: "usd"}}, want: `{"unit_amount":8.00,"up_to":"inf"}`},
+		{name: "flat amount", tier: PriceTierRequest{FlatAmount: &Money{Amount: 500, Currency: "jpy"}, UpTo: 3}, want: `{"flat_amount":500,"up_to":3}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.tier)
+			if err != nil {
+				t.Fatalf("json.Marshal(%+v) error = %v", tt.tier, err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("json.Marshal(%+v) = %s, want %s", tt.tier, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCreatePriceValidation(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request CreatePriceRequest
+		wantErr error
+	}{
+		{name: "per_unit without a unit amount", request: CreatePriceRequest{BillingScheme: PRICE_BILLING_SCHEME_PER_UNIT}, wantErr: pi.ErrRequiredArgumentMissing},
+		{name: "default scheme without a unit amount", request: CreatePriceRequest{}, wantErr: pi.ErrRequiredArgumentMissing},
+		{name: "negative unit amount", request: CreatePriceRequest{UnitAmount: &Money{Amount: -1, Currency: "usd"}}, wantErr: ErrAmountNegative},
+		{name: "unit amount in another currency", request: CreatePriceRequest{UnitAmount: &Money{Amount: 100, Currency: "eur"}}, wantErr: ErrCurrencyMismatch},
+		{name: "tiered without a tiers mode", request: CreatePriceRequest{BillingScheme: PRICE_BILLING_SCHEME_TIERED, Tiers: []PriceTierRequest{{}}}, wantErr: pi.ErrRequiredArgumentMissing},
+		{name: "tiered with an invalid tiers mode", request: CreatePriceRequest{BillingScheme: PRICE_BILLING_SCHEME_TIERED, TiersMode: "stepped"}, wantErr: ErrTiersModeInvalid},
+		{name: "invalid billing scheme", request: CreatePriceRequest{BillingScheme: "metered"}, wantErr: ErrBillingSchemeInvalid},
+		{name: "invalid currency option", request: CreatePriceRequest{
+			UnitAmount:      &Money{Amount: 100, Currency: "usd"},
+			CurrencyOptions: map[string]PriceCurrencyOptionRequest{"eur": {UnitAmount: &Money{Amount: 100, Currency: "usd"}}},
+		}, wantErr: ErrCurrencyMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.request.SaaSKey, tt.request.ProductId, tt.request.Currency = "sk_test", "prod_1", "usd"
+			if _, errorInfo := (&Ai2CClient{}).CreatePrice(context.Background(), tt.request); errors.Is(errorInfo.Error, tt.wantErr) == false {
+				t.Errorf("CreatePrice(%+v) error = %v, want %v", tt.request, errorInfo.Error, tt.wantErr)
+			}
+		})
+	}
+}
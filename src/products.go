@@ -0,0 +1,256 @@
+// Package src
+/*
+These are the product operations of the Ai2CClient.
+
+RESTRICTIONS:
+	None
+
+NOTES:
+    A product is what is sold and a price is how much and how often it is charged. A product can have many prices,
+    see prices.go.
+
+    Products that have been used are not deleted. ArchiveProduct sets Active to false, so the product can no longer
+    be used for new purchases, and keeps it for existing subscriptions and reports.
+
+COPYRIGHT:
+	Copyright 2022
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+
+*/
+package src
+
+import (
+	"context"
+	"fmt"
+
+	ctv "github.com/sty-holdings/constant-type-vars-go/v2024"
+	pi "github.com/sty-holdings/sty-shared/v2024/programInfo"
+)
+
+//goland:noinspection ALL
+const (
+	FN_NAME       = "name"
+	FN_PRODUCT_ID = "product_id"
+)
+
+//goland:noinspection ALL
+const (
+	SUB_STRIPE_CREATE_PRODUCT = "stripe.product.create"
+	SUB_STRIPE_GET_PRODUCT    = "stripe.product.get"
+	SUB_STRIPE_LIST_PRODUCTS  = "stripe.product.list"
+	SUB_STRIPE_UPDATE_PRODUCT = "stripe.product.update"
+)
+
+//goland:noinspection ALL
+const (
+	TXT_PRODUCT = "Product: "
+)
+
+type ArchiveProductRequest struct {
+	SaaSKey   string `json:"saas_key"`
+	ProductId string `json:"id"`
+}
+
+// CreateProductRequest - StatementDescriptor is shown on the customer's card statement and is at most 22
+// characters. TaxCode is a Stripe tax code, for example txcd_10103001 for SaaS.
+type CreateProductRequest struct {
+	SaaSKey             string            `json:"saas_key"`
+	Name                string            `json:"name"`
+	Description         string            `json:"description,omitempty"`
+	Images              []string          `json:"images,omitempty"`
+	Metadata            map[string]string `json:"metadata,omitempty"`
+	StatementDescriptor string            `json:"statement_descriptor,omitempty"`
+	TaxCode             string            `json:"tax_code,omitempty"`
+	UnitLabel           string            `json:"unit_label,omitempty"`
+	URL                 string            `json:"url,omitempty"`
+}
+
+type GetProductRequest struct {
+	SaaSKey   string `json:"saas_key"`
+	ProductId string `json:"id"`
+}
+
+// ListProductsRequest - Active only returns the products that are active, true, or archived, false.
+type ListProductsRequest struct {
+	SaaSKey       string `json:"saas_key"`
+	Active        *bool  `json:"active,omitempty"`
+	Limit         int64  `json:"limit,omitempty"`
+	StartingAfter string `json:"starting_after,omitempty"`
+}
+
+type Product struct {
+	Id                  string            `json:"id"`
+	Object              string            `json:"object,omitempty"`
+	Active              bool              `json:"active"`
+	Created             int64             `json:"created,omitempty"`
+	DefaultPriceId      string            `json:"default_price,omitempty"`
+	Description         string            `json:"description,omitempty"`
+	Images              []string          `json:"images,omitempty"`
+	LiveMode            bool              `json:"livemode,omitempty"`
+	Metadata            map[string]string `json:"metadata,omitempty"`
+	Name                string            `json:"name"`
+	StatementDescriptor string            `json:"statement_descriptor,omitempty"`
+	TaxCode             string            `json:"tax_code,omitempty"`
+	UnitLabel           string            `json:"unit_label,omitempty"`
+	Updated             int64             `json:"updated,omitempty"`
+	URL                 string            `json:"url,omitempty"`
+	RawReply
+}
+
+type ProductList = List[Product]
+
+// UpdateProductRequest - only the fields that are set are changed. Images replaces all the images. Metadata keys
+// are added or replaced, and a key with an empty value is removed.
+type UpdateProductRequest struct {
+	SaaSKey             string            `json:"saas_key"`
+	ProductId           string            `json:"id"`
+	Active              *bool             `json:"active,omitempty"`
+	DefaultPriceId      *string           `json:"default_price,omitempty"`
+	Description         *string           `json:"description,omitempty"`
+	Images              []string          `json:"images,omitempty"`
+	Metadata            map[string]string `json:"metadata,omitempty"`
+	Name                *string           `json:"name,omitempty"`
+	StatementDescriptor *string           `json:"statement_descriptor,omitempty"`
+	TaxCode             *string           `json:"tax_code,omitempty"`
+	UnitLabel           *string           `json:"unit_label,omitempty"`
+	URL                 *string           `json:"url,omitempty"`
+}
+
+// ArchiveProduct - sets the product identified by ProductId to inactive and returns the updated product. The
+// SaaSKey and ProductId are required.
+//
+// Customer Messages: None
+// Errors: Errors returned by UpdateProduct
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) ArchiveProduct(ctx context.Context, request ArchiveProductRequest) (
+	product Product,
+	errorInfo pi.ErrorInfo,
+) {
+
+	var (
+		tActive = false
+	)
+
+	return ai2cClientPtr.UpdateProduct(
+		ctx,
+		UpdateProductRequest{
+			SaaSKey:   request.SaaSKey,
+			ProductId: request.ProductId,
+			Active:    &tActive,
+		},
+	)
+}
+
+// CreateProduct - creates a product. The SaaSKey and Name are required.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) CreateProduct(ctx context.Context, request CreateProductRequest) (
+	product Product,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if request.Name == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_NAME)
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_CREATE_PRODUCT, request, &product)
+
+	return
+}
+
+// GetProduct - returns the product identified by ProductId. The SaaSKey and ProductId are required.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) GetProduct(ctx context.Context, request GetProductRequest) (
+	product Product,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if request.ProductId == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_PRODUCT_ID)
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_GET_PRODUCT, request, &product)
+
+	return
+}
+
+// ListProducts - lists products, newest first. The SaaSKey is required and the Limit must be set to a value between
+// 1 and 100. StartingAfter is the id of the product the list starts after. Use ProductList.Cursor to get the next
+// page.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing, ErrLimitOutOfRange
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) ListProducts(ctx context.Context, request ListProductsRequest) (
+	productList ProductList,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if errorInfo = validateListLimit(request.Limit); errorInfo.Error != nil {
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_LIST_PRODUCTS, request, &productList)
+
+	return
+}
+
+// UpdateProduct - changes the product identified by ProductId and returns the updated product. The SaaSKey,
+// ProductId, and at least one change are required.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing, ErrNoChanges
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) UpdateProduct(ctx context.Context, request UpdateProductRequest) (
+	product Product,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if request.ProductId == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_PRODUCT_ID)
+		return
+	}
+	if request.Active == nil && request.DefaultPriceId == nil && request.Description == nil && len(request.Images) == ctv.VAL_ZERO &&
+		len(request.Metadata) == ctv.VAL_ZERO && request.Name == nil && request.StatementDescriptor == nil && request.TaxCode == nil &&
+		request.UnitLabel == nil && request.URL == nil {
+		errorInfo = pi.NewErrorInfo(ErrNoChanges, fmt.Sprintf("%v%v", TXT_PRODUCT, request.ProductId))
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_UPDATE_PRODUCT, request, &product)
+
+	return
+}
@@ -0,0 +1,308 @@
+// Package src
+/*
+These are the promotion code operations of the Ai2CClient.
+
+RESTRICTIONS:
+	None
+
+NOTES:
+    A promotion code is a code the customer enters to get the discount of a coupon, such as SUMMER25. Many promotion
+    codes can share one coupon, each with its own limits, such as a customer, an expiry, or a minimum amount.
+
+    Promotion codes cannot be deleted. DeactivatePromotionCode stops the promotion code from being redeemed, the same
+    as UpdatePromotionCode with Active set to false.
+
+COPYRIGHT:
+	Copyright 2022
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+
+*/
+package src
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	ctv "github.com/sty-holdings/constant-type-vars-go/v2024"
+	pi "github.com/sty-holdings/sty-shared/v2024/programInfo"
+)
+
+//goland:noinspection ALL
+const (
+	FN_PROMOTION_CODE_ID = "promotion_code_id"
+)
+
+//goland:noinspection ALL
+const (
+	SUB_STRIPE_CREATE_PROMOTION_CODE = "stripe.promotion-code.create"
+	SUB_STRIPE_GET_PROMOTION_CODE    = "stripe.promotion-code.get"
+	SUB_STRIPE_LIST_PROMOTION_CODES  = "stripe.promotion-code.list"
+	SUB_STRIPE_UPDATE_PROMOTION_CODE = "stripe.promotion-code.update"
+)
+
+//goland:noinspection ALL
+const (
+	TXT_PROMOTION_CODE = "Promotion code: "
+)
+
+// CreatePromotionCodeRequest - Code is what the customer enters. When it is empty, a code is generated. CustomerId
+// limits the code to one customer. ExpiresAt, a Unix timestamp, and MaxRedemptions limit when and how many times the
+// code can be redeemed.
+type CreatePromotionCodeRequest struct {
+	SaaSKey        string                            `json:"saas_key"`
+	CouponId       string                            `json:"coupon"`
+	Code           string                            `json:"code,omitempty"`
+	CustomerId     string                            `json:"customer,omitempty"`
+	ExpiresAt      int64                             `json:"expires_at,omitempty"`
+	MaxRedemptions int64                             `json:"max_redemptions,omitempty"`
+	Metadata       map[string]string                 `json:"metadata,omitempty"`
+	Restrictions   *PromotionCodeRestrictionsRequest `json:"restrictions,omitempty"`
+}
+
+// DeactivatePromotionCodeRequest - see DeactivatePromotionCode.
+type DeactivatePromotionCodeRequest struct {
+	SaaSKey         string `json:"saas_key"`
+	PromotionCodeId string `json:"id"`
+}
+
+type GetPromotionCodeRequest struct {
+	SaaSKey         string `json:"saas_key"`
+	PromotionCodeId string `json:"id"`
+}
+
+// ListPromotionCodesRequest - Active, Code, CouponId, and CustomerId only return the promotion codes that match them.
+type ListPromotionCodesRequest struct {
+	SaaSKey       string `json:"saas_key"`
+	Active        *bool  `json:"active,omitempty"`
+	Code          string `json:"code,omitempty"`
+	CouponId      string `json:"coupon,omitempty"`
+	CustomerId    string `json:"customer,omitempty"`
+	Limit         int64  `json:"limit,omitempty"`
+	StartingAfter string `json:"starting_after,omitempty"`
+}
+
+type PromotionCode struct {
+	Id             string                    `json:"id"`
+	Object         string                    `json:"object,omitempty"`
+	Active         bool                      `json:"active"`
+	Code           string                    `json:"code"`
+	Coupon         Coupon                    `json:"coupon"`
+	Created        int64                     `json:"created,omitempty"`
+	CustomerId     string                    `json:"customer,omitempty"`
+	ExpiresAt      int64                     `json:"expires_at,omitempty"`
+	LiveMode       bool                      `json:"livemode,omitempty"`
+	MaxRedemptions int64                     `json:"max_redemptions,omitempty"`
+	Metadata       map[string]string         `json:"metadata,omitempty"`
+	Restrictions   PromotionCodeRestrictions `json:"restrictions"`
+	TimesRedeemed  int64                     `json:"times_redeemed,omitempty"`
+	RawReply
+}
+
+type PromotionCodeList = List[PromotionCode]
+
+// PromotionCodeRestrictions - MinimumAmount is in minor units.
+type PromotionCodeRestrictions struct {
+	FirstTimeTransaction  bool   `json:"first_time_transaction,omitempty"`
+	MinimumAmount         int64  `json:"minimum_amount,omitempty"`
+	MinimumAmountCurrency string `json:"minimum_amount_currency,omitempty"`
+}
+
+// PromotionCodeRestrictionsRequest - FirstTimeTransaction limits the code to customers who have never paid.
+// MinimumAmount is the smallest order total the code can be redeemed on.
+type PromotionCodeRestrictionsRequest struct {
+	FirstTimeTransaction bool   `json:"first_time_transaction,omitempty"`
+	MinimumAmount        *Money `json:"-"`
+}
+
+// UpdatePromotionCodeRequest - only the fields that are set are changed. Metadata keys are added or replaced, and a
+// key with an empty value is removed.
+type UpdatePromotionCodeRequest struct {
+	SaaSKey         string            `json:"saas_key"`
+	PromotionCodeId string            `json:"id"`
+	Active          *bool             `json:"active,omitempty"`
+	Metadata        map[string]string `json:"metadata,omitempty"`
+}
+
+// CreatePromotionCode - creates a promotion code for the coupon identified by CouponId. The SaaSKey and CouponId are
+// required. When Restrictions.MinimumAmount is set, it must be greater than zero.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing, ErrAmountNotPositive, ErrCurrencyInvalid
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) CreatePromotionCode(ctx context.Context, request CreatePromotionCodeRequest) (
+	promotionCode PromotionCode,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if request.CouponId == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_COUPON_ID)
+		return
+	}
+	if request.Restrictions != nil && request.Restrictions.MinimumAmount != nil {
+		if errorInfo = validateAmount(*request.Restrictions.MinimumAmount); errorInfo.Error != nil {
+			return
+		}
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_CREATE_PROMOTION_CODE, request, &promotionCode)
+
+	return
+}
+
+// DeactivatePromotionCode - deactivates the promotion code identified by PromotionCodeId and returns the updated
+// promotion code. Promotion codes cannot be deleted, so this is UpdatePromotionCode with Active set to false. The
+// SaaSKey and PromotionCodeId are required.
+//
+// Customer Messages: None
+// Errors: Errors returned by UpdatePromotionCode
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) DeactivatePromotionCode(ctx context.Context, request DeactivatePromotionCodeRequest) (
+	promotionCode PromotionCode,
+	errorInfo pi.ErrorInfo,
+) {
+
+	var (
+		tActive = false
+	)
+
+	return ai2cClientPtr.UpdatePromotionCode(
+		ctx,
+		UpdatePromotionCodeRequest{
+			SaaSKey:         request.SaaSKey,
+			PromotionCodeId: request.PromotionCodeId,
+			Active:          &tActive,
+		},
+	)
+}
+
+// GetPromotionCode - returns the promotion code identified by PromotionCodeId. The SaaSKey and PromotionCodeId are
+// required.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) GetPromotionCode(ctx context.Context, request GetPromotionCodeRequest) (
+	promotionCode PromotionCode,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if request.PromotionCodeId == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_PROMOTION_CODE_ID)
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_GET_PROMOTION_CODE, request, &promotionCode)
+
+	return
+}
+
+// ListPromotionCodes - lists promotion codes, newest first. The SaaSKey is required and the Limit must be set to a
+// value between 1 and 100. StartingAfter is the id of the promotion code the list starts after. Use
+// PromotionCodeList.Cursor to get the next page.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing, ErrLimitOutOfRange
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) ListPromotionCodes(ctx context.Context, request ListPromotionCodesRequest) (
+	promotionCodeList PromotionCodeList,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if errorInfo = validateListLimit(request.Limit); errorInfo.Error != nil {
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_LIST_PROMOTION_CODES, request, &promotionCodeList)
+
+	return
+}
+
+// UpdatePromotionCode - changes the promotion code identified by PromotionCodeId and returns the updated promotion
+// code. The SaaSKey, PromotionCodeId, and at least one change are required.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing, ErrNoChanges
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) UpdatePromotionCode(ctx context.Context, request UpdatePromotionCodeRequest) (
+	promotionCode PromotionCode,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if request.PromotionCodeId == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_PROMOTION_CODE_ID)
+		return
+	}
+	if request.Active == nil && len(request.Metadata) == ctv.VAL_ZERO {
+		errorInfo = pi.NewErrorInfo(ErrNoChanges, fmt.Sprintf("%v%v", TXT_PROMOTION_CODE, request.PromotionCodeId))
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_UPDATE_PROMOTION_CODE, request, &promotionCode)
+
+	return
+}
+
+// MarshalJSON - encodes the restrictions with MinimumAmount as a decimal in the major unit and the currency next to
+// it. Both are left out when MinimumAmount is nil.
+//
+// Customer Messages: None
+// Errors: json errors
+// Verifications: None
+func (request PromotionCodeRestrictionsRequest) MarshalJSON() ([]byte, error) {
+
+	type tPromotionCodeRestrictionsRequest PromotionCodeRestrictionsRequest
+
+	var (
+		tMinimumAmount json.Number
+		tCurrency      string
+	)
+
+	tMinimumAmount, tCurrency = decimalAmount(request.MinimumAmount)
+
+	return json.Marshal(struct {
+		tPromotionCodeRestrictionsRequest
+		MinimumAmount         json.Number `json:"minimum_amount,omitempty"`
+		MinimumAmountCurrency string      `json:"minimum_amount_currency,omitempty"`
+	}{
+		tPromotionCodeRestrictionsRequest: tPromotionCodeRestrictionsRequest(request),
+		MinimumAmount:                     tMinimumAmount,
+		MinimumAmountCurrency:             tCurrency,
+	})
+}
+
+// MinimumAmountMoney - returns MinimumAmount, which is in minor units, with the currency as Money.
+//
+// Customer Messages: None
+// Errors: None
+// Verifications: None
+func (restrictionsPtr *PromotionCodeRestrictions) MinimumAmountMoney() (minimumAmount Money) {
+
+	return replyMoney(restrictionsPtr.MinimumAmount, restrictionsPtr.MinimumAmountCurrency)
+}
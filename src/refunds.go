@@ -0,0 +1,270 @@
+// Package src
+/*
+These are the refund operations of the Ai2CClient.
+
+RESTRICTIONS:
+	None
+
+NOTES:
+    Refunds are sent to the AI2C service the same way as the payment intent operations. The request is encrypted
+    with the client id and secret key, and the reply is decoded into a typed result.
+
+COPYRIGHT:
+	Copyright 2022
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+
+*/
+package src
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	ctv "github.com/sty-holdings/constant-type-vars-go/v2024"
+	pi "github.com/sty-holdings/sty-shared/v2024/programInfo"
+)
+
+//goland:noinspection ALL
+const (
+	REFUND_REASON_DUPLICATE             = "duplicate"
+	REFUND_REASON_FRAUDULENT            = "fraudulent"
+	REFUND_REASON_REQUESTED_BY_CUSTOMER = "requested_by_customer"
+)
+
+//goland:noinspection ALL
+const (
+	REFUND_STATUS_CANCELED        = "canceled"
+	REFUND_STATUS_FAILED          = "failed"
+	REFUND_STATUS_PENDING         = "pending"
+	REFUND_STATUS_REQUIRES_ACTION = "requires_action"
+	REFUND_STATUS_SUCCEEDED       = "succeeded"
+)
+
+//goland:noinspection ALL
+const (
+	FN_REFUND_ID = "refund_id"
+)
+
+//goland:noinspection ALL
+const (
+	SUB_STRIPE_CANCEL_REFUND = "stripe.refund.cancel"
+	SUB_STRIPE_CREATE_REFUND = "stripe.refund.create"
+	SUB_STRIPE_GET_REFUND    = "stripe.refund.get"
+	SUB_STRIPE_LIST_REFUNDS  = "stripe.refund.list"
+)
+
+//goland:noinspection ALL
+const (
+	TXT_REFUND_REASON = "Refund reason: "
+)
+
+var (
+	ErrRefundReasonInvalid = errors.New("the refund reason must be duplicate, fraudulent, or requested_by_customer")
+)
+
+type CancelRefundRequest struct {
+	SaaSKey  string `json:"saas_key"`
+	RefundId string `json:"id"`
+}
+
+// CreateRefundRequest - refunds Amount of the payment intent. When Amount is nil, the full amount that has not
+// been refunded is refunded.
+type CreateRefundRequest struct {
+	SaaSKey         string            `json:"saas_key"`
+	PaymentIntentId string            `json:"payment_intent"`
+	Amount          *Money            `json:"-"`
+	Metadata        map[string]string `json:"metadata,omitempty"`
+	Reason          string            `json:"reason,omitempty"`
+}
+
+type GetRefundRequest struct {
+	SaaSKey  string `json:"saas_key"`
+	RefundId string `json:"id"`
+}
+
+// ListRefundsRequest - PaymentIntentId only returns the refunds for that payment intent.
+type ListRefundsRequest struct {
+	SaaSKey         string `json:"saas_key"`
+	PaymentIntentId string `json:"payment_intent,omitempty"`
+	Limit           int64  `json:"limit,omitempty"`
+	StartingAfter   string `json:"starting_after,omitempty"`
+}
+
+type Refund struct {
+	Id                   string            `json:"id"`
+	Object               string            `json:"object,omitempty"`
+	Amount               int64             `json:"amount"`
+	BalanceTransactionId string            `json:"balance_transaction,omitempty"`
+	ChargeId             string            `json:"charge,omitempty"`
+	Created              int64             `json:"created,omitempty"`
+	Currency             string            `json:"currency"`
+	FailureReason        string            `json:"failure_reason,omitempty"`
+	Metadata             map[string]string `json:"metadata,omitempty"`
+	PaymentIntentId      string            `json:"payment_intent,omitempty"`
+	Reason               string            `json:"reason,omitempty"`
+	ReceiptNumber        string            `json:"receipt_number,omitempty"`
+	Status               string            `json:"status"`
+	RawReply
+}
+
+type RefundList = List[Refund]
+
+// CancelRefund - cancels a refund that is in the requires_action status and returns the updated refund. The SaaSKey
+// and RefundId are required.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) CancelRefund(ctx context.Context, request CancelRefundRequest) (
+	refund Refund,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if request.RefundId == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_REFUND_ID)
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_CANCEL_REFUND, request, &refund)
+
+	return
+}
+
+// CreateRefund - refunds all or part of a payment intent. The SaaSKey and PaymentIntentId are required. When Amount
+// is set, it must be greater than zero. When Reason is set, it must be one of the REFUND_REASON values.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing, ErrAmountNotPositive, ErrCurrencyInvalid, ErrRefundReasonInvalid
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) CreateRefund(ctx context.Context, request CreateRefundRequest) (
+	refund Refund,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if request.PaymentIntentId == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_PAYMENT_INTENT_ID)
+		return
+	}
+	if request.Amount != nil {
+		if errorInfo = validateAmount(*request.Amount); errorInfo.Error != nil {
+			return
+		}
+	}
+	switch request.Reason {
+	case ctv.VAL_EMPTY, REFUND_REASON_DUPLICATE, REFUND_REASON_FRAUDULENT, REFUND_REASON_REQUESTED_BY_CUSTOMER:
+	default:
+		errorInfo = pi.NewErrorInfo(ErrRefundReasonInvalid, fmt.Sprintf("%v%v", TXT_REFUND_REASON, request.Reason))
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_CREATE_REFUND, request, &refund)
+
+	return
+}
+
+// GetRefund - returns the refund identified by RefundId. The SaaSKey and RefundId are required.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) GetRefund(ctx context.Context, request GetRefundRequest) (
+	refund Refund,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if request.RefundId == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_REFUND_ID)
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_GET_REFUND, request, &refund)
+
+	return
+}
+
+// ListRefunds - lists refunds, newest first. The SaaSKey is required and the Limit must be set to a value between
+// 1 and 100. Providing the PaymentIntentId will only return refunds for that payment intent. StartingAfter is the
+// id of the refund the list starts after. Use RefundList.Cursor to get the next page.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing, ErrLimitOutOfRange
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) ListRefunds(ctx context.Context, request ListRefundsRequest) (
+	refundList RefundList,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if errorInfo = validateListLimit(request.Limit); errorInfo.Error != nil {
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_LIST_REFUNDS, request, &refundList)
+
+	return
+}
+
+// MarshalJSON - encodes the request with Amount as a decimal in the major unit and the currency next to it. Both
+// are left out when Amount is nil.
+//
+// Customer Messages: None
+// Errors: json errors
+// Verifications: None
+func (request CreateRefundRequest) MarshalJSON() ([]byte, error) {
+
+	type tCreateRefundRequest CreateRefundRequest
+
+	var (
+		tAmount   json.Number
+		tCurrency string
+	)
+
+	tAmount, tCurrency = decimalAmount(request.Amount)
+
+	return json.Marshal(struct {
+		tCreateRefundRequest
+		Amount   json.Number `json:"amount,omitempty"`
+		Currency string      `json:"currency,omitempty"`
+	}{
+		tCreateRefundRequest: tCreateRefundRequest(request),
+		Amount:               tAmount,
+		Currency:             tCurrency,
+	})
+}
+
+// AmountMoney - returns Amount, which is in minor units, with the currency as Money.
+//
+// Customer Messages: None
+// Errors: None
+// Verifications: None
+func (refundPtr *Refund) AmountMoney() (amount Money) {
+
+	return replyMoney(refundPtr.Amount, refundPtr.Currency)
+}
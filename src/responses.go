@@ -0,0 +1,313 @@
+// Package src
+/*
+These are the typed replies decoded from the AI2C NATS service.
+
+RESTRICTIONS:
+	None
+
+NOTES:
+    Every reply keeps the undecoded body in Raw for callers that need fields that are not modeled.
+
+COPYRIGHT:
+	Copyright 2022
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+
+*/
+package src
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	ctv "github.com/sty-holdings/constant-type-vars-go/v2024"
+	pi "github.com/sty-holdings/sty-shared/v2024/programInfo"
+)
+
+//goland:noinspection ALL
+const (
+	PAYMENT_INTENT_STATUS_CANCELED                = "canceled"
+	PAYMENT_INTENT_STATUS_PROCESSING              = "processing"
+	PAYMENT_INTENT_STATUS_REQUIRES_ACTION         = "requires_action"
+	PAYMENT_INTENT_STATUS_REQUIRES_CAPTURE        = "requires_capture"
+	PAYMENT_INTENT_STATUS_REQUIRES_CONFIRMATION   = "requires_confirmation"
+	PAYMENT_INTENT_STATUS_REQUIRES_PAYMENT_METHOD = "requires_payment_method"
+	PAYMENT_INTENT_STATUS_SUCCEEDED               = "succeeded"
+)
+
+//goland:noinspection ALL
+const (
+	NEXT_ACTION_TYPE_REDIRECT_TO_URL = "redirect_to_url"
+	NEXT_ACTION_TYPE_USE_STRIPE_SDK  = "use_stripe_sdk"
+)
+
+//goland:noinspection ALL
+const (
+	TXT_REPLY_DECODE_FAILED = "The reply could not be decoded. "
+)
+
+var (
+	ErrReplyError = errors.New("the AI2C service returned an error")
+)
+
+// record - implemented by every record that is returned in a List, so the cursor for the next page can be found.
+type record interface {
+	GetId() string
+}
+
+// replyHolder - implemented by every reply model so the undecoded body can be kept.
+type replyHolder interface {
+	setRaw(raw []byte)
+}
+
+type CancelResult struct {
+	PaymentIntent
+}
+
+// DeletedResult - the reply to a delete operation. Deleted is true when the object was deleted.
+type DeletedResult struct {
+	Id      string `json:"id"`
+	Object  string `json:"object,omitempty"`
+	Deleted bool   `json:"deleted"`
+	RawReply
+}
+
+// List - a page of records. When HasMore is true, pass Cursor as StartingAfter to get the next page.
+type List[T record] struct {
+	Object  string `json:"object,omitempty"`
+	Data    []T    `json:"data"`
+	HasMore bool   `json:"has_more"`
+	URL     string `json:"url,omitempty"`
+	RawReply
+}
+
+// NextAction - what the customer must do before the payment intent can continue. For redirect_to_url, send the
+// customer to RedirectToURL.URL. For use_stripe_sdk, pass the payment intent's ClientSecret to Stripe.js or the
+// mobile SDK, which handles the authentication.
+type NextAction struct {
+	Type          string                   `json:"type"`
+	RedirectToURL *NextActionRedirectToURL `json:"redirect_to_url,omitempty"`
+	UseStripeSDK  json.RawMessage          `json:"use_stripe_sdk,omitempty"`
+}
+
+type NextActionRedirectToURL struct {
+	ReturnURL string `json:"return_url,omitempty"`
+	URL       string `json:"url"`
+}
+
+type PaymentIntent struct {
+	Id                 string            `json:"id"`
+	Object             string            `json:"object,omitempty"`
+	Amount             int64             `json:"amount"`
+	AmountCapturable   int64             `json:"amount_capturable,omitempty"`
+	AmountReceived     int64             `json:"amount_received,omitempty"`
+	CanceledAt         int64             `json:"canceled_at,omitempty"`
+	CancellationReason string            `json:"cancellation_reason,omitempty"`
+	CaptureMethod      string            `json:"capture_method,omitempty"`
+	ClientSecret       string            `json:"client_secret,omitempty"`
+	Created            int64             `json:"created,omitempty"`
+	Currency           string            `json:"currency"`
+	CustomerId         string            `json:"customer,omitempty"`
+	Description        string            `json:"description,omitempty"`
+	LiveMode           bool              `json:"livemode,omitempty"`
+	Metadata           map[string]string `json:"metadata,omitempty"`
+	NextAction         *NextAction       `json:"next_action,omitempty"`
+	PaymentMethodId    string            `json:"payment_method,omitempty"`
+	PaymentMethodTypes []string          `json:"payment_method_types,omitempty"`
+	ReceiptEmail       string            `json:"receipt_email,omitempty"`
+	Status             string            `json:"status"`
+	RawReply
+}
+
+type PaymentIntentList = List[PaymentIntent]
+
+type PaymentMethod struct {
+	Id             string                      `json:"id"`
+	Object         string                      `json:"object,omitempty"`
+	BillingDetails PaymentMethodBillingDetails `json:"billing_details,omitempty"`
+	Card           *PaymentMethodCard          `json:"card,omitempty"`
+	Created        int64                       `json:"created,omitempty"`
+	CustomerId     string                      `json:"customer,omitempty"`
+	LiveMode       bool                        `json:"livemode,omitempty"`
+	Metadata       map[string]string           `json:"metadata,omitempty"`
+	Type           string                      `json:"type"`
+	RawReply
+}
+
+type PaymentMethodBillingDetails struct {
+	Email string `json:"email,omitempty"`
+	Name  string `json:"name,omitempty"`
+	Phone string `json:"phone,omitempty"`
+}
+
+type PaymentMethodCard struct {
+	Brand    string `json:"brand,omitempty"`
+	Country  string `json:"country,omitempty"`
+	ExpMonth int64  `json:"exp_month,omitempty"`
+	ExpYear  int64  `json:"exp_year,omitempty"`
+	Funding  string `json:"funding,omitempty"`
+	Last4    string `json:"last4,omitempty"`
+}
+
+type PaymentMethodList = List[PaymentMethod]
+
+// RawReply - embedded in every reply model. Raw holds the undecoded body.
+type RawReply struct {
+	Raw json.RawMessage `json:"-"`
+}
+
+// ReplyError - the error object the AI2C service returns, for example, when a card is declined. Requests return it
+// wrapped in ErrReplyError, so use errors.As to read the Code, DeclineCode, and Param.
+type ReplyError struct {
+	Code        string `json:"code,omitempty"`
+	DeclineCode string `json:"decline_code,omitempty"`
+	Message     string `json:"message"`
+	Param       string `json:"param,omitempty"`
+	Type        string `json:"type,omitempty"`
+}
+
+// AmountMoney - returns Amount, which is in minor units, with the currency as Money.
+//
+//	Customer Messages: None
+//	Errors: None
+//	Verifications: None
+func (paymentIntentPtr *PaymentIntent) AmountMoney() (amount Money) {
+
+	return replyMoney(paymentIntentPtr.Amount, paymentIntentPtr.Currency)
+}
+
+// Canceled - returns true when the payment intent is in the canceled status.
+//
+//	Customer Messages: None
+//	Errors: None
+//	Verifications: None
+func (cancelResultPtr *CancelResult) Canceled() bool {
+
+	return cancelResultPtr.Status == PAYMENT_INTENT_STATUS_CANCELED
+}
+
+// Cursor - returns the id to pass as StartingAfter to get the next page. It is empty when there are no more records.
+//
+//	Customer Messages: None
+//	Errors: None
+//	Verifications: None
+func (listPtr *List[T]) Cursor() (startingAfter string) {
+
+	if listPtr.HasMore == false || len(listPtr.Data) == ctv.VAL_ZERO {
+		return
+	}
+
+	return listPtr.Data[len(listPtr.Data)-1].GetId()
+}
+
+// Error - formats the reply error so it can be used as an error message.
+//
+//	Customer Messages: None
+//	Errors: None
+//	Verifications: None
+func (replyError *ReplyError) Error() string {
+
+	if replyError.Code == ctv.VAL_EMPTY {
+		return replyError.Message
+	}
+
+	return fmt.Sprintf("%v (%v)", replyError.Message, replyError.Code)
+}
+
+// GetId - returns the record's Id. List uses it to find the cursor for the next page.
+func (balanceTransaction BalanceTransaction) GetId() string { return balanceTransaction.Id }
+func (coupon Coupon) GetId() string                         { return coupon.Id }
+func (customer Customer) GetId() string                     { return customer.Id }
+func (dispute Dispute) GetId() string                       { return dispute.Id }
+func (invoice Invoice) GetId() string                       { return invoice.Id }
+func (invoiceItem InvoiceItem) GetId() string               { return invoiceItem.Id }
+func (invoiceLine InvoiceLine) GetId() string               { return invoiceLine.Id }
+func (paymentIntent PaymentIntent) GetId() string           { return paymentIntent.Id }
+func (paymentLink PaymentLink) GetId() string               { return paymentLink.Id }
+func (paymentMethod PaymentMethod) GetId() string           { return paymentMethod.Id }
+func (payout Payout) GetId() string                         { return payout.Id }
+func (price Price) GetId() string                           { return price.Id }
+func (product Product) GetId() string                       { return product.Id }
+func (promotionCode PromotionCode) GetId() string           { return promotionCode.Id }
+func (refund Refund) GetId() string                         { return refund.Id }
+func (setupIntent SetupIntent) GetId() string               { return setupIntent.Id }
+func (subscription Subscription) GetId() string             { return subscription.Id }
+func (subscriptionItem SubscriptionItem) GetId() string     { return subscriptionItem.Id }
+
+// RedirectURL - returns the URL to send the customer to when Type is redirect_to_url. Otherwise, it is empty.
+//
+//	Customer Messages: None
+//	Errors: None
+//	Verifications: None
+func (nextActionPtr *NextAction) RedirectURL() (url string) {
+
+	if nextActionPtr == nil || nextActionPtr.Type != NEXT_ACTION_TYPE_REDIRECT_TO_URL || nextActionPtr.RedirectToURL == nil {
+		return
+	}
+
+	return nextActionPtr.RedirectToURL.URL
+}
+
+// RequiresAction - returns true when the customer must authenticate, for example with 3-D Secure, before the
+// payment intent can continue. NextAction holds what the customer must do.
+//
+//	Customer Messages: None
+//	Errors: None
+//	Verifications: None
+func (paymentIntentPtr *PaymentIntent) RequiresAction() bool {
+
+	return paymentIntentPtr.Status == PAYMENT_INTENT_STATUS_REQUIRES_ACTION
+}
+
+func (rawReplyPtr *RawReply) setRaw(raw []byte) { rawReplyPtr.Raw = raw }
+
+// Private Function below here
+
+// decodeReply - unmarshals the reply into replyPtr and keeps the undecoded body. When the reply carries an
+// error object, it is returned wrapped in ErrReplyError, so callers can get the *ReplyError with errors.As. replyPtr
+// is left untouched.
+//
+//	Customer Messages: None
+//	Errors: ErrReplyError, json errors
+//	Verifications: None
+func decodeReply(subject string, reply []byte, replyPtr interface{}) (errorInfo pi.ErrorInfo) {
+
+	var (
+		tErrorReply struct {
+			Error *ReplyError `json:"error"`
+		}
+	)
+
+	if replyPtr == nil {
+		return
+	}
+
+	if errorInfo.Error = json.Unmarshal(reply, &tErrorReply); errorInfo.Error != nil {
+		errorInfo = pi.NewErrorInfo(errorInfo.Error, fmt.Sprintf("%v%v%v", TXT_REPLY_DECODE_FAILED, ctv.TXT_SUBJECT, subject))
+		return
+	}
+	if tErrorReply.Error != nil {
+		errorInfo = pi.NewErrorInfo(fmt.Errorf("%w: %w", ErrReplyError, tErrorReply.Error), fmt.Sprintf("%v%v", ctv.TXT_SUBJECT, subject))
+		return
+	}
+
+	if errorInfo.Error = json.Unmarshal(reply, replyPtr); errorInfo.Error != nil {
+		errorInfo = pi.NewErrorInfo(errorInfo.Error, fmt.Sprintf("%v%v%v", TXT_REPLY_DECODE_FAILED, ctv.TXT_SUBJECT, subject))
+		return
+	}
+	if tHolder, ok := replyPtr.(replyHolder); ok {
+		tHolder.setRaw(reply)
+	}
+
+	return
+}
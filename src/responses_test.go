@@ -0,0 +1,126 @@
+package src
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDecodeReply(t *testing.T) {
+
+	tests := []struct {
+		name       string
+		reply      string
+		wantErr    error
+		wantAnyErr bool
+		wantId     string
+		wantRaw    bool
+	}{
+		{name: "record", reply: `{"id":"pi_1","amount":100,"currency":"usd","status":"succeeded"}`, wantId: "pi_1", wantRaw: true},
+		{name: "unknown fields are kept in raw", reply: `{"id":"pi_2","status":"succeeded","extra":true}`, wantId: "pi_2", wantRaw: true},
+		{name: "error envelope", reply: `{"error":{"code":"card_declined","message":"Your card was declined."}}`, wantErr: ErrReplyError},
+		{name: "error envelope without a code", reply: `{"error":{"message":"failed"}}`, wantErr: ErrReplyError},
+		{name: "null error is not an error", reply: `{"error":null,"id":"pi_3","status":"succeeded"}`, wantId: "pi_3", wantRaw: true},
+		{name: "invalid json", reply: `{"id":`, wantAnyErr: true},
+		{name: "wrong type", reply: `{"id":1}`, wantAnyErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var tPaymentIntent PaymentIntent
+
+			errorInfo := decodeReply("test.subject", []byte(tt.reply), &tPaymentIntent)
+			switch {
+			case tt.wantErr != nil:
+				if errors.Is(errorInfo.Error, tt.wantErr) == false {
+					t.Fatalf("decodeReply(%s) error = %v, want %v", tt.reply, errorInfo.Error, tt.wantErr)
+				}
+			case tt.wantAnyErr:
+				if errorInfo.Error == nil || errors.Is(errorInfo.Error, ErrReplyError) {
+					t.Fatalf("decodeReply(%s) error = %v, want a decode error", tt.reply, errorInfo.Error)
+				}
+			case errorInfo.Error != nil:
+				t.Fatalf("decodeReply(%s) error = %v", tt.reply, errorInfo.Error)
+			}
+
+			if tPaymentIntent.Id != tt.wantId {
+				t.Errorf("decodeReply(%s) Id = %q, want %q", tt.reply, tPaymentIntent.Id, tt.wantId)
+			}
+			if tt.wantRaw && string(tPaymentIntent.Raw) != tt.reply {
+				t.Errorf("decodeReply(%s) Raw = %s", tt.reply, tPaymentIntent.Raw)
+			}
+			if tt.wantRaw == false && tPaymentIntent.Raw != nil {
+				t.Errorf("decodeReply(%s) Raw = %s, want nil", tt.reply, tPaymentIntent.Raw)
+			}
+		})
+	}
+}
+
+func TestDecodeReplyErrorDetails(t *testing.T) {
+
+	var (
+		tPaymentIntent PaymentIntent
+		tReplyErrorPtr *ReplyError
+		tReply         = `{"error":{"code":"card_declined","decline_code":"insufficient_funds","message":"Your card has insufficient funds.","param":"payment_method","type":"card_error"}}`
+	)
+
+	errorInfo := decodeReply("test.subject", []byte(tReply), &tPaymentIntent)
+	if errors.Is(errorInfo.Error, ErrReplyError) == false {
+		t.Fatalf("decodeReply(%s) error = %v, want %v", tReply, errorInfo.Error, ErrReplyError)
+	}
+	if errors.As(errorInfo.Error, &tReplyErrorPtr) == false {
+		t.Fatalf("decodeReply(%s) error = %v, want a *ReplyError in the chain", tReply, errorInfo.Error)
+	}
+	if tReplyErrorPtr.Code != "card_declined" || tReplyErrorPtr.DeclineCode != "insufficient_funds" ||
+		tReplyErrorPtr.Param != "payment_method" || tReplyErrorPtr.Type != "card_error" {
+		t.Errorf("decodeReply(%s) reply error = %+v", tReply, *tReplyErrorPtr)
+	}
+}
+
+func TestDecodeReplyEmbeddedRaw(t *testing.T) {
+
+	var (
+		tCancelResult CancelResult
+		tList         PaymentIntentList
+		tReply        = `{"id":"pi_1","status":"canceled"}`
+		tListReply    = `{"data":[{"id":"pi_1"},{"id":"pi_2"}],"has_more":true}`
+	)
+
+	if errorInfo := decodeReply("test.subject", []byte(tReply), &tCancelResult); errorInfo.Error != nil {
+		t.Fatalf("decodeReply(%s) error = %v", tReply, errorInfo.Error)
+	}
+	if string(tCancelResult.Raw) != tReply || tCancelResult.Canceled() == false {
+		t.Errorf("decodeReply(%s) = %+v", tReply, tCancelResult)
+	}
+
+	if errorInfo := decodeReply("test.subject", []byte(tListReply), &tList); errorInfo.Error != nil {
+		t.Fatalf("decodeReply(%s) error = %v", tListReply, errorInfo.Error)
+	}
+	if string(tList.Raw) != tListReply || len(tList.Data) != 2 {
+		t.Errorf("decodeReply(%s) = %+v", tListReply, tList)
+	}
+
+	if errorInfo := decodeReply("test.subject", []byte(tReply), nil); errorInfo.Error != nil {
+		t.Errorf("decodeReply with a nil reply error = %v", errorInfo.Error)
+	}
+}
+
+func TestListCursor(t *testing.T) {
+
+	tests := []struct {
+		name string
+		list PaymentIntentList
+		want string
+	}{
+		{name: "more records", list: PaymentIntentList{Data: []PaymentIntent{{Id: "pi_1"}, {Id: "pi_2"}}, HasMore: true}, want: "pi_2"},
+		{name: "last page", list: PaymentIntentList{Data: []PaymentIntent{{Id: "pi_1"}}}, want: ""},
+		{name: "no records", list: PaymentIntentList{HasMore: true}, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.list.Cursor(); got != tt.want {
+				t.Errorf("Cursor() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,292 @@
+// Package src
+/*
+These are the setup intent operations of the Ai2CClient. A setup intent saves a payment method for later payments
+without charging it.
+
+RESTRICTIONS:
+	None
+
+NOTES:
+    The request and reply flow is the same as for payment intents. When the customer must authenticate, for example
+    with 3-D Secure, the status is requires_action and NextAction holds what the customer must do.
+
+    Usage off_session is for payment methods charged later without the customer present, such as subscriptions.
+
+COPYRIGHT:
+	Copyright 2022
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+
+*/
+package src
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	ctv "github.com/sty-holdings/constant-type-vars-go/v2024"
+	pi "github.com/sty-holdings/sty-shared/v2024/programInfo"
+)
+
+//goland:noinspection ALL
+const (
+	SETUP_INTENT_STATUS_CANCELED                = "canceled"
+	SETUP_INTENT_STATUS_PROCESSING              = "processing"
+	SETUP_INTENT_STATUS_REQUIRES_ACTION         = "requires_action"
+	SETUP_INTENT_STATUS_REQUIRES_CONFIRMATION   = "requires_confirmation"
+	SETUP_INTENT_STATUS_REQUIRES_PAYMENT_METHOD = "requires_payment_method"
+	SETUP_INTENT_STATUS_SUCCEEDED               = "succeeded"
+)
+
+//goland:noinspection ALL
+const (
+	SETUP_INTENT_USAGE_OFF_SESSION = "off_session"
+	SETUP_INTENT_USAGE_ON_SESSION  = "on_session"
+)
+
+//goland:noinspection ALL
+const (
+	FN_SETUP_INTENT_ID = "setup_intent_id"
+)
+
+//goland:noinspection ALL
+const (
+	SUB_STRIPE_CANCEL_SETUP_INTENT  = "stripe.setup-intent.cancel"
+	SUB_STRIPE_CONFIRM_SETUP_INTENT = "stripe.setup-intent.confirm"
+	SUB_STRIPE_CREATE_SETUP_INTENT  = "stripe.setup-intent.create"
+	SUB_STRIPE_GET_SETUP_INTENT     = "stripe.setup-intent.get"
+	SUB_STRIPE_LIST_SETUP_INTENTS   = "stripe.setup-intent.list"
+)
+
+//goland:noinspection ALL
+const (
+	TXT_USAGE = "Usage: "
+)
+
+var (
+	ErrUsageInvalid = errors.New("the usage must be on_session or off_session")
+)
+
+// CancelSetupIntentRequest - CancellationReason is optional and is one of abandoned, duplicate, or
+// requested_by_customer.
+type CancelSetupIntentRequest struct {
+	SaaSKey            string `json:"saas_key"`
+	SetupIntentId      string `json:"id"`
+	CancellationReason string `json:"cancellation_reason,omitempty"`
+}
+
+// ConfirmSetupIntentRequest - ReturnURL is where the customer is sent after completing 3-D Secure or another
+// redirect based authentication. It is required by payment methods that redirect.
+type ConfirmSetupIntentRequest struct {
+	SaaSKey         string `json:"saas_key"`
+	SetupIntentId   string `json:"id"`
+	PaymentMethodId string `json:"payment_method"`
+	ReturnURL       string `json:"return_url,omitempty"`
+}
+
+// CreateSetupIntentRequest - Usage defaults to off_session. Confirm confirms the setup intent when it is created
+// and requires PaymentMethodId.
+type CreateSetupIntentRequest struct {
+	SaaSKey                 string            `json:"saas_key"`
+	AutomaticPaymentMethods bool              `json:"automatic_payment_methods,omitempty"`
+	Confirm                 bool              `json:"confirm,omitempty"`
+	CustomerId              string            `json:"customer,omitempty"`
+	Description             string            `json:"description,omitempty"`
+	Metadata                map[string]string `json:"metadata,omitempty"`
+	PaymentMethodId         string            `json:"payment_method,omitempty"`
+	PaymentMethodTypes      []string          `json:"payment_method_types,omitempty"`
+	ReturnURL               string            `json:"return_url,omitempty"`
+	Usage                   string            `json:"usage,omitempty"`
+}
+
+type GetSetupIntentRequest struct {
+	SaaSKey       string `json:"saas_key"`
+	SetupIntentId string `json:"id"`
+}
+
+// ListSetupIntentsRequest - CustomerId and PaymentMethodId only return the setup intents for that customer or
+// payment method.
+type ListSetupIntentsRequest struct {
+	SaaSKey         string `json:"saas_key"`
+	CustomerId      string `json:"customer,omitempty"`
+	PaymentMethodId string `json:"payment_method,omitempty"`
+	Limit           int64  `json:"limit,omitempty"`
+	StartingAfter   string `json:"starting_after,omitempty"`
+}
+
+type SetupIntent struct {
+	Id                 string            `json:"id"`
+	Object             string            `json:"object,omitempty"`
+	CancellationReason string            `json:"cancellation_reason,omitempty"`
+	ClientSecret       string            `json:"client_secret,omitempty"`
+	Created            int64             `json:"created,omitempty"`
+	CustomerId         string            `json:"customer,omitempty"`
+	Description        string            `json:"description,omitempty"`
+	LastSetupError     *ReplyError       `json:"last_setup_error,omitempty"`
+	LiveMode           bool              `json:"livemode,omitempty"`
+	Metadata           map[string]string `json:"metadata,omitempty"`
+	NextAction         *NextAction       `json:"next_action,omitempty"`
+	PaymentMethodId    string            `json:"payment_method,omitempty"`
+	PaymentMethodTypes []string          `json:"payment_method_types,omitempty"`
+	Status             string            `json:"status"`
+	Usage              string            `json:"usage,omitempty"`
+	RawReply
+}
+
+type SetupIntentList = List[SetupIntent]
+
+// CancelSetupIntent - cancels the setup intent identified by SetupIntentId and returns the updated setup intent.
+// The SaaSKey and SetupIntentId are required.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) CancelSetupIntent(ctx context.Context, request CancelSetupIntentRequest) (
+	setupIntent SetupIntent,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if request.SetupIntentId == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SETUP_INTENT_ID)
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_CANCEL_SETUP_INTENT, request, &setupIntent)
+
+	return
+}
+
+// ConfirmSetupIntent - confirms the setup intent identified by SetupIntentId with the payment method and returns
+// the updated setup intent. The SaaSKey, SetupIntentId, and PaymentMethodId are required. When the customer must
+// authenticate, use RequiresAction and NextAction.RedirectURL to handle it.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) ConfirmSetupIntent(ctx context.Context, request ConfirmSetupIntentRequest) (
+	setupIntent SetupIntent,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if request.SetupIntentId == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SETUP_INTENT_ID)
+		return
+	}
+	if request.PaymentMethodId == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_PAYMENT_METHOD)
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_CONFIRM_SETUP_INTENT, request, &setupIntent)
+
+	return
+}
+
+// CreateSetupIntent - creates a setup intent. The SaaSKey is required. When Usage is set, it must be on_session or
+// off_session. When Confirm is set, PaymentMethodId is required.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing, ErrUsageInvalid
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) CreateSetupIntent(ctx context.Context, request CreateSetupIntentRequest) (
+	setupIntent SetupIntent,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	switch request.Usage {
+	case ctv.VAL_EMPTY, SETUP_INTENT_USAGE_OFF_SESSION, SETUP_INTENT_USAGE_ON_SESSION:
+	default:
+		errorInfo = pi.NewErrorInfo(ErrUsageInvalid, fmt.Sprintf("%v%v", TXT_USAGE, request.Usage))
+		return
+	}
+	if request.Confirm && request.PaymentMethodId == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_PAYMENT_METHOD)
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_CREATE_SETUP_INTENT, request, &setupIntent)
+
+	return
+}
+
+// GetSetupIntent - returns the setup intent identified by SetupIntentId. The SaaSKey and SetupIntentId are required.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) GetSetupIntent(ctx context.Context, request GetSetupIntentRequest) (
+	setupIntent SetupIntent,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if request.SetupIntentId == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SETUP_INTENT_ID)
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_GET_SETUP_INTENT, request, &setupIntent)
+
+	return
+}
+
+// ListSetupIntents - lists setup intents, newest first. The SaaSKey is required and the Limit must be set to a
+// value between 1 and 100. StartingAfter is the id of the setup intent the list starts after. Use
+// SetupIntentList.Cursor to get the next page.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing, ErrLimitOutOfRange
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) ListSetupIntents(ctx context.Context, request ListSetupIntentsRequest) (
+	setupIntentList SetupIntentList,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if errorInfo = validateListLimit(request.Limit); errorInfo.Error != nil {
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_LIST_SETUP_INTENTS, request, &setupIntentList)
+
+	return
+}
+
+// RequiresAction - returns true when the customer must authenticate, for example with 3-D Secure, before the
+// payment method can be saved. NextAction holds what the customer must do.
+//
+// Customer Messages: None
+// Errors: None
+// Verifications: None
+func (setupIntentPtr *SetupIntent) RequiresAction() bool {
+
+	return setupIntentPtr.Status == SETUP_INTENT_STATUS_REQUIRES_ACTION
+}
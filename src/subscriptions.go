@@ -0,0 +1,418 @@
+// Package src
+/*
+These are the subscription operations of the Ai2CClient.
+
+RESTRICTIONS:
+	None
+
+NOTES:
+    A subscription bills the customer for one or more prices on a recurring schedule. The customer must have a
+    default payment method, or DefaultPaymentMethodId must be set, for the first invoice to be paid.
+
+    ProrationBehavior controls how plan and quantity changes are charged. When it is empty, the AI2C service uses
+    create_prorations.
+
+COPYRIGHT:
+	Copyright 2022
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+
+*/
+package src
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	ctv "github.com/sty-holdings/constant-type-vars-go/v2024"
+	pi "github.com/sty-holdings/sty-shared/v2024/programInfo"
+)
+
+//goland:noinspection ALL
+const (
+	PRORATION_BEHAVIOR_ALWAYS_INVOICE   = "always_invoice"
+	PRORATION_BEHAVIOR_CREATE_PRORATION = "create_prorations"
+	PRORATION_BEHAVIOR_NONE             = "none"
+)
+
+//goland:noinspection ALL
+const (
+	SUBSCRIPTION_STATUS_ACTIVE             = "active"
+	SUBSCRIPTION_STATUS_CANCELED           = "canceled"
+	SUBSCRIPTION_STATUS_INCOMPLETE         = "incomplete"
+	SUBSCRIPTION_STATUS_INCOMPLETE_EXPIRED = "incomplete_expired"
+	SUBSCRIPTION_STATUS_PAST_DUE           = "past_due"
+	SUBSCRIPTION_STATUS_PAUSED             = "paused"
+	SUBSCRIPTION_STATUS_TRIALING           = "trialing"
+	SUBSCRIPTION_STATUS_UNPAID             = "unpaid"
+)
+
+//goland:noinspection ALL
+const (
+	FN_ITEMS           = "items"
+	FN_PRICE_ID        = "price"
+	FN_SUBSCRIPTION_ID = "subscription_id"
+)
+
+//goland:noinspection ALL
+const (
+	SUB_STRIPE_CANCEL_SUBSCRIPTION = "stripe.subscription.cancel"
+	SUB_STRIPE_CREATE_SUBSCRIPTION = "stripe.subscription.create"
+	SUB_STRIPE_GET_SUBSCRIPTION    = "stripe.subscription.get"
+	SUB_STRIPE_LIST_SUBSCRIPTIONS  = "stripe.subscription.list"
+	SUB_STRIPE_UPDATE_SUBSCRIPTION = "stripe.subscription.update"
+)
+
+//goland:noinspection ALL
+const (
+	TXT_PRORATION_BEHAVIOR = "Proration behavior: "
+	TXT_QUANTITY           = "Quantity: "
+	TXT_SUBSCRIPTION       = "Subscription: "
+	TXT_TRIAL              = "Trial end and period days: "
+)
+
+var (
+	ErrAtPeriodEndInvalid       = errors.New("invoice now and prorate cannot be set when canceling at the period end")
+	ErrProrationBehaviorInvalid = errors.New("the proration behavior must be always_invoice, create_prorations, or none")
+	ErrQuantityInvalid          = errors.New("the quantity is out of range")
+	ErrTrialInvalid             = errors.New("only one of the trial end and the trial period days can be set")
+)
+
+// CancelSubscriptionRequest - when AtPeriodEnd is set, the subscription stays active until the end of the current
+// period and is then canceled. Otherwise, it is canceled immediately. InvoiceNow and Prorate only apply to an
+// immediate cancel and cannot be set with AtPeriodEnd.
+type CancelSubscriptionRequest struct {
+	SaaSKey        string `json:"saas_key"`
+	SubscriptionId string `json:"id"`
+	AtPeriodEnd    bool   `json:"-"`
+	InvoiceNow     bool   `json:"invoice_now,omitempty"`
+	Prorate        bool   `json:"prorate,omitempty"`
+}
+
+// CreateSubscriptionRequest - TrialPeriodDays and TrialEnd, a Unix timestamp, start the subscription with a free
+// trial. Only one of them can be set. Discounts applies coupons or promotion codes to the subscription.
+type CreateSubscriptionRequest struct {
+	SaaSKey                string                    `json:"saas_key"`
+	CustomerId             string                    `json:"customer"`
+	Items                  []SubscriptionItemRequest `json:"items"`
+	CancelAtPeriodEnd      bool                      `json:"cancel_at_period_end,omitempty"`
+	DefaultPaymentMethodId string                    `json:"default_payment_method,omitempty"`
+	Description            string                    `json:"description,omitempty"`
+	Discounts              []DiscountRequest         `json:"discounts,omitempty"`
+	Metadata               map[string]string         `json:"metadata,omitempty"`
+	ProrationBehavior      string                    `json:"proration_behavior,omitempty"`
+	TrialEnd               int64                     `json:"trial_end,omitempty"`
+	TrialPeriodDays        int64                     `json:"trial_period_days,omitempty"`
+}
+
+type GetSubscriptionRequest struct {
+	SaaSKey        string `json:"saas_key"`
+	SubscriptionId string `json:"id"`
+}
+
+// ListSubscriptionsRequest - CustomerId, PriceId, and Status only return the subscriptions that match them.
+type ListSubscriptionsRequest struct {
+	SaaSKey       string `json:"saas_key"`
+	CustomerId    string `json:"customer,omitempty"`
+	PriceId       string `json:"price,omitempty"`
+	Status        string `json:"status,omitempty"`
+	Limit         int64  `json:"limit,omitempty"`
+	StartingAfter string `json:"starting_after,omitempty"`
+}
+
+type Subscription struct {
+	Id                     string               `json:"id"`
+	Object                 string               `json:"object,omitempty"`
+	BillingCycleAnchor     int64                `json:"billing_cycle_anchor,omitempty"`
+	CancelAt               int64                `json:"cancel_at,omitempty"`
+	CancelAtPeriodEnd      bool                 `json:"cancel_at_period_end,omitempty"`
+	CanceledAt             int64                `json:"canceled_at,omitempty"`
+	Created                int64                `json:"created,omitempty"`
+	Currency               string               `json:"currency,omitempty"`
+	CurrentPeriodEnd       int64                `json:"current_period_end,omitempty"`
+	CurrentPeriodStart     int64                `json:"current_period_start,omitempty"`
+	CustomerId             string               `json:"customer,omitempty"`
+	DefaultPaymentMethodId string               `json:"default_payment_method,omitempty"`
+	Description            string               `json:"description,omitempty"`
+	DiscountIds            []string             `json:"discounts,omitempty"`
+	EndedAt                int64                `json:"ended_at,omitempty"`
+	Items                  SubscriptionItemList `json:"items"`
+	LatestInvoiceId        string               `json:"latest_invoice,omitempty"`
+	LiveMode               bool                 `json:"livemode,omitempty"`
+	Metadata               map[string]string    `json:"metadata,omitempty"`
+	Status                 string               `json:"status"`
+	TrialEnd               int64                `json:"trial_end,omitempty"`
+	TrialStart             int64                `json:"trial_start,omitempty"`
+	RawReply
+}
+
+type SubscriptionItem struct {
+	Id             string            `json:"id"`
+	Object         string            `json:"object,omitempty"`
+	Created        int64             `json:"created,omitempty"`
+	Metadata       map[string]string `json:"metadata,omitempty"`
+	Price          Price             `json:"price"`
+	Quantity       int64             `json:"quantity,omitempty"`
+	SubscriptionId string            `json:"subscription,omitempty"`
+}
+
+type SubscriptionItemList = List[SubscriptionItem]
+
+// SubscriptionItemRequest - when creating a subscription, PriceId is required. When updating a subscription, Id
+// identifies an existing item to change, an item without an Id is added, and Deleted removes the item.
+type SubscriptionItemRequest struct {
+	Id       string `json:"id,omitempty"`
+	Deleted  bool   `json:"deleted,omitempty"`
+	PriceId  string `json:"price,omitempty"`
+	Quantity int64  `json:"quantity,omitempty"`
+}
+
+type SubscriptionList = List[Subscription]
+
+// UpdateSubscriptionRequest - only the fields that are set are changed. Items changes the plan or quantity, see
+// SubscriptionItemRequest. ProrationBehavior is not a change on its own, it controls how the changes are charged.
+type UpdateSubscriptionRequest struct {
+	SaaSKey                string                    `json:"saas_key"`
+	SubscriptionId         string                    `json:"id"`
+	CancelAtPeriodEnd      *bool                     `json:"cancel_at_period_end,omitempty"`
+	DefaultPaymentMethodId *string                   `json:"default_payment_method,omitempty"`
+	Description            *string                   `json:"description,omitempty"`
+	Items                  []SubscriptionItemRequest `json:"items,omitempty"`
+	Metadata               map[string]string         `json:"metadata,omitempty"`
+	ProrationBehavior      string                    `json:"proration_behavior,omitempty"`
+	TrialEnd               *int64                    `json:"trial_end,omitempty"`
+}
+
+// CancelSubscription - cancels the subscription identified by SubscriptionId and returns the updated subscription.
+// When AtPeriodEnd is set, the subscription is updated to cancel at the end of the current period. The SaaSKey and
+// SubscriptionId are required.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing, ErrAtPeriodEndInvalid, Errors returned by UpdateSubscription
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) CancelSubscription(ctx context.Context, request CancelSubscriptionRequest) (
+	subscription Subscription,
+	errorInfo pi.ErrorInfo,
+) {
+
+	var (
+		tCancelAtPeriodEnd = true
+	)
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if request.SubscriptionId == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SUBSCRIPTION_ID)
+		return
+	}
+	if request.AtPeriodEnd && (request.InvoiceNow || request.Prorate) {
+		errorInfo = pi.NewErrorInfo(ErrAtPeriodEndInvalid, fmt.Sprintf("%v%v", TXT_SUBSCRIPTION, request.SubscriptionId))
+		return
+	}
+
+	if request.AtPeriodEnd {
+		return ai2cClientPtr.UpdateSubscription(
+			ctx,
+			UpdateSubscriptionRequest{
+				SaaSKey:           request.SaaSKey,
+				SubscriptionId:    request.SubscriptionId,
+				CancelAtPeriodEnd: &tCancelAtPeriodEnd,
+			},
+		)
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_CANCEL_SUBSCRIPTION, request, &subscription)
+
+	return
+}
+
+// CreateSubscription - creates a subscription for the customer. The SaaSKey, CustomerId, and at least one item with
+// a PriceId are required. When ProrationBehavior is set, it must be one of the PRORATION_BEHAVIOR values. TrialEnd
+// and TrialPeriodDays cannot both be set.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing, ErrQuantityInvalid, ErrProrationBehaviorInvalid, ErrTrialInvalid,
+// ErrDiscountInvalid
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) CreateSubscription(ctx context.Context, request CreateSubscriptionRequest) (
+	subscription Subscription,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if request.CustomerId == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_CUSTOMER_ID)
+		return
+	}
+	if len(request.Items) == ctv.VAL_ZERO {
+		errorInfo = missingParameter(FN_ITEMS)
+		return
+	}
+	for _, item := range request.Items {
+		if item.PriceId == ctv.VAL_EMPTY {
+			errorInfo = missingParameter(FN_PRICE_ID)
+			return
+		}
+	}
+	if errorInfo = validateSubscriptionItems(request.Items); errorInfo.Error != nil {
+		return
+	}
+	if errorInfo = validateProrationBehavior(request.ProrationBehavior); errorInfo.Error != nil {
+		return
+	}
+	if request.TrialEnd != ctv.VAL_ZERO && request.TrialPeriodDays != ctv.VAL_ZERO {
+		errorInfo = pi.NewErrorInfo(ErrTrialInvalid, fmt.Sprintf("%v%v %v", TXT_TRIAL, request.TrialEnd, request.TrialPeriodDays))
+		return
+	}
+	if errorInfo = validateDiscounts(request.Discounts); errorInfo.Error != nil {
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_CREATE_SUBSCRIPTION, request, &subscription)
+
+	return
+}
+
+// GetSubscription - returns the subscription identified by SubscriptionId. The SaaSKey and SubscriptionId are
+// required.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) GetSubscription(ctx context.Context, request GetSubscriptionRequest) (
+	subscription Subscription,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if request.SubscriptionId == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SUBSCRIPTION_ID)
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_GET_SUBSCRIPTION, request, &subscription)
+
+	return
+}
+
+// ListSubscriptions - lists subscriptions, newest first. The SaaSKey is required and the Limit must be set to a
+// value between 1 and 100. StartingAfter is the id of the subscription the list starts after. Use
+// SubscriptionList.Cursor to get the next page.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing, ErrLimitOutOfRange
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) ListSubscriptions(ctx context.Context, request ListSubscriptionsRequest) (
+	subscriptionList SubscriptionList,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if errorInfo = validateListLimit(request.Limit); errorInfo.Error != nil {
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_LIST_SUBSCRIPTIONS, request, &subscriptionList)
+
+	return
+}
+
+// UpdateSubscription - changes the subscription identified by SubscriptionId and returns the updated subscription.
+// The SaaSKey, SubscriptionId, and at least one change are required. When ProrationBehavior is set, it must be one
+// of the PRORATION_BEHAVIOR values.
+//
+// Customer Messages: None
+// Errors: ErrRequiredArgumentMissing, ErrNoChanges, ErrQuantityInvalid, ErrProrationBehaviorInvalid
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) UpdateSubscription(ctx context.Context, request UpdateSubscriptionRequest) (
+	subscription Subscription,
+	errorInfo pi.ErrorInfo,
+) {
+
+	if request.SaaSKey == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SAAS_KEY)
+		return
+	}
+	if request.SubscriptionId == ctv.VAL_EMPTY {
+		errorInfo = missingParameter(FN_SUBSCRIPTION_ID)
+		return
+	}
+	if request.CancelAtPeriodEnd == nil && request.DefaultPaymentMethodId == nil && request.Description == nil &&
+		len(request.Items) == ctv.VAL_ZERO && len(request.Metadata) == ctv.VAL_ZERO && request.TrialEnd == nil {
+		errorInfo = pi.NewErrorInfo(ErrNoChanges, fmt.Sprintf("%v%v", TXT_SUBSCRIPTION, request.SubscriptionId))
+		return
+	}
+	for _, item := range request.Items {
+		if item.Id == ctv.VAL_EMPTY && item.PriceId == ctv.VAL_EMPTY {
+			errorInfo = missingParameter(FN_PRICE_ID)
+			return
+		}
+	}
+	if errorInfo = validateSubscriptionItems(request.Items); errorInfo.Error != nil {
+		return
+	}
+	if errorInfo = validateProrationBehavior(request.ProrationBehavior); errorInfo.Error != nil {
+		return
+	}
+
+	errorInfo = ai2cClientPtr.processRequest(ctx, SUB_STRIPE_UPDATE_SUBSCRIPTION, request, &subscription)
+
+	return
+}
+
+// Private Function below here
+
+// validateProrationBehavior - returns an error unless the proration behavior is empty or one of the
+// PRORATION_BEHAVIOR values.
+//
+//	Customer Messages: None
+//	Errors: ErrProrationBehaviorInvalid
+//	Verifications: None
+func validateProrationBehavior(prorationBehavior string) (errorInfo pi.ErrorInfo) {
+
+	switch prorationBehavior {
+	case ctv.VAL_EMPTY, PRORATION_BEHAVIOR_ALWAYS_INVOICE, PRORATION_BEHAVIOR_CREATE_PRORATION, PRORATION_BEHAVIOR_NONE:
+	default:
+		errorInfo = pi.NewErrorInfo(ErrProrationBehaviorInvalid, fmt.Sprintf("%v%v", TXT_PRORATION_BEHAVIOR, prorationBehavior))
+	}
+
+	return
+}
+
+// validateSubscriptionItems - returns an error when an item has a negative quantity.
+//
+//	Customer Messages: None
+//	Errors: ErrQuantityInvalid
+//	Verifications: None
+func validateSubscriptionItems(items []SubscriptionItemRequest) (errorInfo pi.ErrorInfo) {
+
+	for _, item := range items {
+		if item.Quantity < 0 {
+			errorInfo = pi.NewErrorInfo(ErrQuantityInvalid, fmt.Sprintf("%v%v", TXT_QUANTITY, item.Quantity))
+			return
+		}
+	}
+
+	return
+}
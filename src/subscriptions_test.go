@@ -0,0 +1,102 @@
+package src_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"ai2c-go-client/src"
+)
+
+func TestCreateSubscriptionTrial(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request src.CreateSubscriptionRequest
+		wantErr error
+	}{
+		{name: "trial end", request: src.CreateSubscriptionRequest{TrialEnd: 1767225600}},
+		{name: "trial period days", request: src.CreateSubscriptionRequest{TrialPeriodDays: 14}},
+		{name: "trial end and period days", request: src.CreateSubscriptionRequest{TrialEnd: 1767225600, TrialPeriodDays: 14}, wantErr: src.ErrTrialInvalid},
+	}
+
+	tServerPtr, tClientPtr := newFakeServerClient(t)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tServerPtr.Reset()
+			if errorInfo := tServerPtr.Reply(src.SUB_STRIPE_CREATE_SUBSCRIPTION, src.Subscription{Id: "sub_1"}); errorInfo.Error != nil {
+				t.Fatalf("Reply error = %v", errorInfo.Error)
+			}
+			tt.request.SaaSKey, tt.request.CustomerId = "sk_test", "cus_1"
+			tt.request.Items = []src.SubscriptionItemRequest{{PriceId: "price_1"}}
+			if _, errorInfo := tClientPtr.CreateSubscription(context.Background(), tt.request); errors.Is(errorInfo.Error, tt.wantErr) == false {
+				t.Errorf("CreateSubscription(%+v) error = %v, want %v", tt.request, errorInfo.Error, tt.wantErr)
+			}
+			if tt.wantErr != nil && len(tServerPtr.Requests()) != 0 {
+				t.Errorf("requests = %+v, want none", tServerPtr.Requests())
+			}
+		})
+	}
+}
+
+func TestCancelSubscriptionAtPeriodEnd(t *testing.T) {
+
+	var (
+		tSent src.UpdateSubscriptionRequest
+	)
+
+	tServerPtr, tClientPtr := newFakeServerClient(t)
+	if errorInfo := tServerPtr.Reply(src.SUB_STRIPE_UPDATE_SUBSCRIPTION, src.Subscription{Id: "sub_1", CancelAtPeriodEnd: true}); errorInfo.Error != nil {
+		t.Fatalf("Reply error = %v", errorInfo.Error)
+	}
+
+	tSubscription, errorInfo := tClientPtr.CancelSubscription(
+		context.Background(),
+		src.CancelSubscriptionRequest{SaaSKey: "sk_test", SubscriptionId: "sub_1", AtPeriodEnd: true},
+	)
+	if errorInfo.Error != nil {
+		t.Fatalf("CancelSubscription error = %v", errorInfo.Error)
+	}
+	if tSubscription.CancelAtPeriodEnd == false {
+		t.Errorf("CancelSubscription = %+v, want the subscription canceling at the period end", tSubscription)
+	}
+
+	tRequests := tServerPtr.Requests()
+	if len(tRequests) != 1 || tRequests[0].Subject != src.SUB_STRIPE_UPDATE_SUBSCRIPTION {
+		t.Fatalf("requests = %+v, want one %v request", tRequests, src.SUB_STRIPE_UPDATE_SUBSCRIPTION)
+	}
+	if err := json.Unmarshal(tRequests[0].Data, &tSent); err != nil {
+		t.Fatalf("json.Unmarshal(%s) error = %v", tRequests[0].Data, err)
+	}
+	if tSent.SubscriptionId != "sub_1" || tSent.CancelAtPeriodEnd == nil || *tSent.CancelAtPeriodEnd == false {
+		t.Errorf("sent %s, want cancel_at_period_end set to true", tRequests[0].Data)
+	}
+}
+
+func TestCancelSubscriptionAtPeriodEndInvalid(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request src.CancelSubscriptionRequest
+	}{
+		{name: "invoice now", request: src.CancelSubscriptionRequest{AtPeriodEnd: true, InvoiceNow: true}},
+		{name: "prorate", request: src.CancelSubscriptionRequest{AtPeriodEnd: true, Prorate: true}},
+	}
+
+	tServerPtr, tClientPtr := newFakeServerClient(t)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.request.SaaSKey, tt.request.SubscriptionId = "sk_test", "sub_1"
+			if _, errorInfo := tClientPtr.CancelSubscription(context.Background(), tt.request); errors.Is(errorInfo.Error, src.ErrAtPeriodEndInvalid) == false {
+				t.Errorf("CancelSubscription(%+v) error = %v, want %v", tt.request, errorInfo.Error, src.ErrAtPeriodEndInvalid)
+			}
+		})
+	}
+
+	if tRequests := tServerPtr.Requests(); len(tRequests) != 0 {
+		t.Errorf("requests = %+v, want none", tRequests)
+	}
+}
@@ -0,0 +1,486 @@
+// Package src
+/*
+This keeps the AWS Cognito tokens used by the Ai2CClient valid.
+
+RESTRICTIONS:
+	None
+
+NOTES:
+    The expiry is read from the exp claim of the ID token. Before the token expires, it is refreshed using the
+    refresh token. When the refresh fails, for example, because the refresh token has expired, a full SRP login is
+    done. The password is kept in memory for that login until the client is closed.
+
+    After the tokens are renewed, the client reads the parameters from AWS SSM again. When they have changed, it
+    connects to the NATS service with them and closes the old connection once its in-flight requests finish.
+
+COPYRIGHT:
+	Copyright 2022
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+
+*/
+package src
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cip "github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
+	cipTypes "github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider/types"
+	ctv "github.com/sty-holdings/constant-type-vars-go/v2024"
+	awss "github.com/sty-holdings/sty-shared/v2024/awsServices"
+	pi "github.com/sty-holdings/sty-shared/v2024/programInfo"
+)
+
+//goland:noinspection ALL
+const (
+	COGNITO_REFRESH_TOKEN        = "REFRESH_TOKEN"
+	DEFAULT_TOKEN_REFRESH_MARGIN = 5 * time.Minute
+	TOKEN_RETRY_INTERVAL         = 30 * time.Second
+)
+
+//goland:noinspection ALL
+const (
+	TXT_TOKEN = "Token: "
+)
+
+var (
+	ErrTokenInvalid = errors.New("the token could not be parsed")
+)
+
+// TokenHooks - functions called by the token manager. Any of them can be nil. They are called from the token
+// manager's goroutine, so they should return quickly.
+type TokenHooks struct {
+	// OnError - called when both the refresh and the SRP login fail. The manager retries after TOKEN_RETRY_INTERVAL.
+	OnError func(errorInfo pi.ErrorInfo)
+	// OnLogin - called after a full SRP login with the new expiry.
+	OnLogin func(expiresAt time.Time)
+	// OnRefresh - called after the tokens are refreshed using the refresh token with the new expiry.
+	OnRefresh func(expiresAt time.Time)
+}
+
+type tokenClaims struct {
+	ClientId string `json:"client_id"`
+	Expires  int64  `json:"exp"`
+}
+
+type tokenManager struct {
+	awsSettings   awss.AWSSettings
+	cancel        context.CancelFunc
+	ctx           context.Context
+	expiresAt     time.Time
+	hooks         TokenHooks
+	lock          sync.Mutex
+	onRenew       func(ctx context.Context)
+	password      string
+	refreshMargin time.Duration
+	tokens        awss.CognitoTokens
+	username      string
+}
+
+// WithTokenHooks - sets the functions called when the Cognito tokens are refreshed, a login is done, or both fail.
+func WithTokenHooks(hooks TokenHooks) Option {
+	return func(configPtr *clientConfig) {
+		configPtr.tokenHooks = hooks
+	}
+}
+
+// WithTokenRefreshMargin - sets how long before the tokens expire they are refreshed. The default is
+// DEFAULT_TOKEN_REFRESH_MARGIN.
+func WithTokenRefreshMargin(refreshMargin time.Duration) Option {
+	return func(configPtr *clientConfig) {
+		configPtr.tokenRefreshMargin = refreshMargin
+	}
+}
+
+// TokenExpiresAt - returns when the current Cognito ID token expires. It is the zero time when the client has no tokens.
+//
+// Customer Messages: None
+// Errors: None
+// Verifications: None
+func (ai2cClientPtr *Ai2CClient) TokenExpiresAt() (expiresAt time.Time) {
+
+	if ai2cClientPtr.tokenManagerPtr == nil {
+		return
+	}
+
+	ai2cClientPtr.tokenManagerPtr.lock.Lock()
+	defer ai2cClientPtr.tokenManagerPtr.lock.Unlock()
+
+	return ai2cClientPtr.tokenManagerPtr.expiresAt
+}
+
+// Private Function below here
+
+// callHooks - calls OnError, OnLogin, or OnRefresh with the result of renew. The lock must not be held, so the
+// hooks can call TokenExpiresAt.
+//
+//	Customer Messages: None
+//	Errors: None
+//	Verifications: None
+func (tokenManagerPtr *tokenManager) callHooks(loggedIn bool, expiresAt time.Time, errorInfo pi.ErrorInfo) {
+
+	switch {
+	case errorInfo.Error != nil:
+		if tokenManagerPtr.hooks.OnError != nil {
+			tokenManagerPtr.hooks.OnError(errorInfo)
+		}
+	case loggedIn:
+		if tokenManagerPtr.hooks.OnLogin != nil {
+			tokenManagerPtr.hooks.OnLogin(expiresAt)
+		}
+	default:
+		if tokenManagerPtr.hooks.OnRefresh != nil {
+			tokenManagerPtr.hooks.OnRefresh(expiresAt)
+		}
+	}
+}
+
+// getIDToken - returns an ID token that is valid for at least the refresh margin, renewing it first if needed.
+//
+//	Customer Messages: None
+//	Errors: Errors returned by renew
+//	Verifications: None
+func (tokenManagerPtr *tokenManager) getIDToken(ctx context.Context) (
+	idToken string,
+	errorInfo pi.ErrorInfo,
+) {
+
+	var (
+		tExpiresAt time.Time
+		tLoggedIn  bool
+		tRenew     bool
+	)
+
+	tokenManagerPtr.lock.Lock()
+	tRenew = time.Until(tokenManagerPtr.expiresAt) <= tokenManagerPtr.refreshMargin
+	idToken = tokenManagerPtr.tokens.ID
+	tokenManagerPtr.lock.Unlock()
+
+	if tRenew == false {
+		return
+	}
+
+	tLoggedIn, idToken, tExpiresAt, errorInfo = tokenManagerPtr.renew(ctx)
+	if tokenManagerPtr.ctx.Err() == nil {
+		tokenManagerPtr.callHooks(tLoggedIn, tExpiresAt, errorInfo)
+	}
+
+	return
+}
+
+// login - does a full SRP login and returns the tokens. It is called without the lock, so the password is passed in.
+//
+//	Customer Messages: None
+//	Errors: Errors returned by awss.Login
+//	Verifications: None
+func (tokenManagerPtr *tokenManager) login(password string) (
+	tokens awss.CognitoTokens,
+	errorInfo pi.ErrorInfo,
+) {
+
+	tokens.Access, tokens.ID, tokens.Refresh, errorInfo = awss.Login(
+		ctv.AUTH_USER_SRP, tokenManagerPtr.username, &password,
+		tokenManagerPtr.awsSettings.STYHCognitoIdentityInfo, tokenManagerPtr.awsSettings.BaseConfig,
+	)
+
+	return
+}
+
+// newTokenManager - logs into AWS Cognito and returns a manager holding the tokens. The refresh goroutine is not
+// started until start is called.
+//
+//	Customer Messages: None
+//	Errors: Errors returned by awss.Login, ErrTokenInvalid
+//	Verifications: None
+func newTokenManager(awsSettings awss.AWSSettings, username, password string, refreshMargin time.Duration, hooks TokenHooks) (
+	tokenManagerPtr *tokenManager,
+	errorInfo pi.ErrorInfo,
+) {
+
+	var (
+		tTokens awss.CognitoTokens
+	)
+
+	if refreshMargin <= 0 {
+		refreshMargin = DEFAULT_TOKEN_REFRESH_MARGIN
+	}
+
+	tokenManagerPtr = &tokenManager{
+		awsSettings:   awsSettings,
+		hooks:         hooks,
+		password:      password,
+		refreshMargin: refreshMargin,
+		username:      username,
+	}
+	tokenManagerPtr.ctx, tokenManagerPtr.cancel = context.WithCancel(context.Background())
+
+	if tTokens, errorInfo = tokenManagerPtr.login(password); errorInfo.Error == nil {
+		_, errorInfo = tokenManagerPtr.swapTokens(awss.CognitoTokens{}, tTokens)
+	}
+	if errorInfo.Error != nil {
+		tokenManagerPtr.cancel()
+		tokenManagerPtr = nil
+	}
+
+	return
+}
+
+// parseTokenClaims - decodes the claims of a JWT without verifying the signature. The token came directly from
+// AWS Cognito, so only the expiry and client id are read.
+//
+//	Customer Messages: None
+//	Errors: ErrTokenInvalid
+//	Verifications: None
+func parseTokenClaims(token string) (
+	claims tokenClaims,
+	errorInfo pi.ErrorInfo,
+) {
+
+	var (
+		tParts   = strings.Split(token, ".")
+		tPayload []byte
+	)
+
+	if len(tParts) != 3 {
+		errorInfo = pi.NewErrorInfo(ErrTokenInvalid, fmt.Sprintf("%v%v", TXT_TOKEN, "wrong number of segments"))
+		return
+	}
+	if tPayload, errorInfo.Error = base64.RawURLEncoding.DecodeString(tParts[1]); errorInfo.Error != nil {
+		errorInfo = pi.NewErrorInfo(ErrTokenInvalid, fmt.Sprintf("%v%v", TXT_TOKEN, errorInfo.Error.Error()))
+		return
+	}
+	if errorInfo.Error = json.Unmarshal(tPayload, &claims); errorInfo.Error != nil {
+		errorInfo = pi.NewErrorInfo(ErrTokenInvalid, fmt.Sprintf("%v%v", TXT_TOKEN, errorInfo.Error.Error()))
+		return
+	}
+	if claims.Expires == ctv.VAL_ZERO {
+		errorInfo = pi.NewErrorInfo(ErrTokenInvalid, fmt.Sprintf("%v%v", TXT_TOKEN, "exp claim is missing"))
+	}
+
+	return
+}
+
+// refresh - gets new access and ID tokens from AWS Cognito using the refresh token in tokens. It is called without
+// the lock.
+//
+//	Customer Messages: None
+//	Errors: Errors returned by AWS Cognito, ErrTokenInvalid
+//	Verifications: None
+func (tokenManagerPtr *tokenManager) refresh(ctx context.Context, tokens awss.CognitoTokens) (
+	newTokens awss.CognitoTokens,
+	errorInfo pi.ErrorInfo,
+) {
+
+	var (
+		tClaims tokenClaims
+		tOutput *cip.InitiateAuthOutput
+	)
+
+	if tokens.Refresh == ctv.VAL_EMPTY {
+		errorInfo = pi.NewErrorInfo(ErrTokenInvalid, fmt.Sprintf("%v%v", TXT_TOKEN, "refresh token is missing"))
+		return
+	}
+	// Cognito access tokens carry the app client id that issued them.
+	if tClaims, errorInfo = parseTokenClaims(tokens.Access); errorInfo.Error != nil {
+		return
+	}
+
+	if tOutput, errorInfo.Error = cip.NewFromConfig(tokenManagerPtr.awsSettings.BaseConfig).InitiateAuth(
+		ctx, &cip.InitiateAuthInput{
+			AuthFlow:       cipTypes.AuthFlowTypeRefreshTokenAuth,
+			ClientId:       aws.String(tClaims.ClientId),
+			AuthParameters: map[string]string{COGNITO_REFRESH_TOKEN: tokens.Refresh},
+		},
+	); errorInfo.Error != nil {
+		errorInfo = pi.NewErrorInfo(errorInfo.Error, fmt.Sprintf("%v%v", TXT_TOKEN, "refresh failed"))
+		return
+	}
+	if tOutput == nil || tOutput.AuthenticationResult == nil {
+		errorInfo = pi.NewErrorInfo(ErrTokenInvalid, fmt.Sprintf("%v%v", TXT_TOKEN, "refresh returned no tokens"))
+		return
+	}
+
+	newTokens.Access = aws.ToString(tOutput.AuthenticationResult.AccessToken)
+	newTokens.ID = aws.ToString(tOutput.AuthenticationResult.IdToken)
+	newTokens.Refresh = tokens.Refresh
+	// Cognito only returns a refresh token when it has been rotated.
+	if tOutput.AuthenticationResult.RefreshToken != nil {
+		newTokens.Refresh = aws.ToString(tOutput.AuthenticationResult.RefreshToken)
+	}
+
+	return
+}
+
+// renew - refreshes the tokens and falls back to a full SRP login when the refresh fails. loggedIn is true when the
+// login was needed. AWS Cognito is called without the lock, so requests and stop don't wait on it. The caller
+// passes the result to callHooks.
+//
+//	Customer Messages: None
+//	Errors: Errors returned by refresh, login, and swapTokens
+//	Verifications: None
+func (tokenManagerPtr *tokenManager) renew(ctx context.Context) (
+	loggedIn bool,
+	idToken string,
+	expiresAt time.Time,
+	errorInfo pi.ErrorInfo,
+) {
+
+	var (
+		tNewTokens awss.CognitoTokens
+		tPassword  string
+		tTokens    awss.CognitoTokens
+	)
+
+	tokenManagerPtr.lock.Lock()
+	tPassword = tokenManagerPtr.password
+	tTokens = tokenManagerPtr.tokens
+	tokenManagerPtr.lock.Unlock()
+
+	if tNewTokens, errorInfo = tokenManagerPtr.refresh(ctx, tTokens); errorInfo.Error != nil {
+		// The SRP login can't be canceled, so it isn't started once ctx is done.
+		if ctx.Err() != nil {
+			return
+		}
+		if tNewTokens, errorInfo = tokenManagerPtr.login(tPassword); errorInfo.Error != nil {
+			return
+		}
+		loggedIn = true
+	}
+
+	if expiresAt, errorInfo = tokenManagerPtr.swapTokens(tTokens, tNewTokens); errorInfo.Error != nil {
+		return
+	}
+
+	tokenManagerPtr.lock.Lock()
+	idToken = tokenManagerPtr.tokens.ID
+	tokenManagerPtr.lock.Unlock()
+
+	return
+}
+
+// run - renews the tokens shortly before they expire until stop is called. After each renewal, onRenew is called
+// so the client can read the parameters again with the new ID token.
+//
+//	Customer Messages: None
+//	Errors: None
+//	Verifications: None
+func (tokenManagerPtr *tokenManager) run() {
+
+	var (
+		tErrorInfo pi.ErrorInfo
+		tExpiresAt time.Time
+		tLoggedIn  bool
+		tTimer     *time.Timer
+		tWait      time.Duration
+	)
+
+	for {
+		tokenManagerPtr.lock.Lock()
+		tWait = time.Until(tokenManagerPtr.expiresAt) - tokenManagerPtr.refreshMargin
+		tokenManagerPtr.lock.Unlock()
+		// Retrying right away after a failure, or when the tokens live for less than the margin, would call
+		// AWS Cognito in a tight loop.
+		if tErrorInfo.Error != nil || tWait < TOKEN_RETRY_INTERVAL {
+			tWait = TOKEN_RETRY_INTERVAL
+		}
+
+		tTimer = time.NewTimer(tWait)
+		select {
+		case <-tokenManagerPtr.ctx.Done():
+			tTimer.Stop()
+			return
+		case <-tTimer.C:
+		}
+
+		tLoggedIn, _, tExpiresAt, tErrorInfo = tokenManagerPtr.renew(tokenManagerPtr.ctx)
+		if tokenManagerPtr.ctx.Err() != nil {
+			return
+		}
+
+		tokenManagerPtr.callHooks(tLoggedIn, tExpiresAt, tErrorInfo)
+		if tErrorInfo.Error == nil && tokenManagerPtr.onRenew != nil {
+			tokenManagerPtr.onRenew(tokenManagerPtr.ctx)
+		}
+	}
+}
+
+// start - starts the goroutine that renews the tokens before they expire.
+//
+//	Customer Messages: None
+//	Errors: None
+//	Verifications: None
+func (tokenManagerPtr *tokenManager) start() {
+
+	go tokenManagerPtr.run()
+}
+
+// stop - stops the renewal goroutine and clears the password and tokens from memory. AWS Cognito is called without
+// the lock, so stop doesn't wait on a renewal that is in progress, and its tokens are not stored. It is safe to call
+// more than once.
+//
+//	Customer Messages: None
+//	Errors: None
+//	Verifications: None
+func (tokenManagerPtr *tokenManager) stop() {
+
+	tokenManagerPtr.cancel()
+
+	tokenManagerPtr.lock.Lock()
+	defer tokenManagerPtr.lock.Unlock()
+
+	tokenManagerPtr.password = ctv.TXT_PROTECTED // Clear the password from memory.
+	tokenManagerPtr.tokens = awss.CognitoTokens{}
+}
+
+// swapTokens - stores newTokens and the expiry read from their ID token when the stored tokens are still
+// oldTokens. Otherwise, another renewal finished first and its tokens are kept. expiresAt is the expiry of the
+// stored tokens.
+//
+//	Customer Messages: None
+//	Errors: ErrTokenInvalid, ErrClientClosed
+//	Verifications: None
+func (tokenManagerPtr *tokenManager) swapTokens(oldTokens, newTokens awss.CognitoTokens) (
+	expiresAt time.Time,
+	errorInfo pi.ErrorInfo,
+) {
+
+	var (
+		tClaims tokenClaims
+	)
+
+	if tClaims, errorInfo = parseTokenClaims(newTokens.ID); errorInfo.Error != nil {
+		return
+	}
+
+	tokenManagerPtr.lock.Lock()
+	defer tokenManagerPtr.lock.Unlock()
+
+	// stop has cleared the tokens, so they must not be stored again.
+	if tokenManagerPtr.ctx.Err() != nil {
+		errorInfo = pi.NewErrorInfo(ErrClientClosed, fmt.Sprintf("%v%v", TXT_TOKEN, "the token manager is stopped"))
+		return
+	}
+	if tokenManagerPtr.tokens.ID == oldTokens.ID {
+		tokenManagerPtr.tokens = newTokens
+		tokenManagerPtr.expiresAt = time.Unix(tClaims.Expires, 0)
+	}
+
+	return tokenManagerPtr.expiresAt, errorInfo
+}
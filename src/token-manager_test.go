@@ -0,0 +1,83 @@
+package src
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	awss "github.com/sty-holdings/sty-shared/v2024/awsServices"
+)
+
+func TestSwapTokens(t *testing.T) {
+
+	var (
+		tOld     = awss.CognitoTokens{ID: testToken(1000), Refresh: "refresh"}
+		tNew     = awss.CognitoTokens{ID: testToken(2000), Refresh: "refresh"}
+		tOther   = awss.CognitoTokens{ID: testToken(3000), Refresh: "refresh"}
+		tManager = newTestTokenManager(tOld)
+	)
+
+	if expiresAt, errorInfo := tManager.swapTokens(tOld, tNew); errorInfo.Error != nil || expiresAt.Unix() != 2000 {
+		t.Fatalf("swapTokens(old, new) = %v, %v, want %v, nil", expiresAt.Unix(), errorInfo.Error, 2000)
+	}
+	if tManager.tokens.ID != tNew.ID {
+		t.Errorf("ID token = %q, want %q", tManager.tokens.ID, tNew.ID)
+	}
+
+	// A renewal that started from the old tokens finished after another one, so its tokens are dropped.
+	if expiresAt, errorInfo := tManager.swapTokens(tOld, tOther); errorInfo.Error != nil || expiresAt.Unix() != 2000 {
+		t.Fatalf("swapTokens(old, other) = %v, %v, want %v, nil", expiresAt.Unix(), errorInfo.Error, 2000)
+	}
+	if tManager.tokens.ID != tNew.ID {
+		t.Errorf("ID token = %q, want %q", tManager.tokens.ID, tNew.ID)
+	}
+
+	if _, errorInfo := tManager.swapTokens(tNew, awss.CognitoTokens{ID: "not.a-token"}); errors.Is(errorInfo.Error, ErrTokenInvalid) == false {
+		t.Errorf("swapTokens with an invalid token error = %v, want %v", errorInfo.Error, ErrTokenInvalid)
+	}
+
+	tManager.stop()
+	if _, errorInfo := tManager.swapTokens(awss.CognitoTokens{}, tOther); errors.Is(errorInfo.Error, ErrClientClosed) == false {
+		t.Errorf("swapTokens after stop error = %v, want %v", errorInfo.Error, ErrClientClosed)
+	}
+	if tManager.tokens.ID != "" || tManager.tokens.Refresh != "" {
+		t.Errorf("tokens after stop = %+v, want none", tManager.tokens)
+	}
+}
+
+func TestGetIDTokenDoesNotRenewValidToken(t *testing.T) {
+
+	var (
+		tTokens  = awss.CognitoTokens{ID: testToken(time.Now().Add(time.Hour).Unix())}
+		tManager = newTestTokenManager(tTokens)
+	)
+
+	// The manager has no AWS settings, so a renewal would fail.
+	if idToken, errorInfo := tManager.getIDToken(context.Background()); errorInfo.Error != nil || idToken != tTokens.ID {
+		t.Errorf("getIDToken() = %q, %v, want %q, nil", idToken, errorInfo.Error, tTokens.ID)
+	}
+}
+
+// newTestTokenManager - returns a manager holding tokens that has not logged in.
+func newTestTokenManager(tokens awss.CognitoTokens) (tokenManagerPtr *tokenManager) {
+
+	tokenManagerPtr = &tokenManager{refreshMargin: DEFAULT_TOKEN_REFRESH_MARGIN, tokens: tokens}
+	tokenManagerPtr.ctx, tokenManagerPtr.cancel = context.WithCancel(context.Background())
+	if tClaims, errorInfo := parseTokenClaims(tokens.ID); errorInfo.Error == nil {
+		tokenManagerPtr.expiresAt = time.Unix(tClaims.Expires, 0)
+	}
+
+	return
+}
+
+// testToken - returns an unsigned JWT with the expiry set to expires.
+func testToken(expires int64) (token string) {
+
+	return fmt.Sprintf(
+		"header.%v.signature",
+		base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"client_id":"client","exp":%v}`, expires))),
+	)
+}